@@ -0,0 +1,17 @@
+data "jamfplatform_blueprints_blueprints" "all" {
+  sort = ["name:asc"]
+}
+
+output "all_blueprints" {
+  value = data.jamfplatform_blueprints_blueprints.all.blueprints
+}
+
+data "jamfplatform_blueprints_blueprints" "failed_baselines" {
+  search           = "Baseline"
+  deployment_state = "FAILED"
+  name_regex       = "^Baseline - "
+}
+
+output "failed_baseline_names" {
+  value = [for bp in data.jamfplatform_blueprints_blueprints.failed_baselines.blueprints : bp.name]
+}
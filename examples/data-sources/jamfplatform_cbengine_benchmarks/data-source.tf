@@ -0,0 +1,26 @@
+data "jamfplatform_cbengine_benchmarks" "all" {}
+
+output "benchmark_sync_states" {
+  value = { for b in data.jamfplatform_cbengine_benchmarks.all.benchmarks : b.title => b.sync_state }
+}
+
+# Fail the run when any benchmark is in the FAILED sync state.
+data "jamfplatform_cbengine_benchmarks" "failed" {
+  sync_state = "FAILED"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.benchmarks) == 0
+      error_message = "Benchmarks failed to sync: ${join(", ", self.benchmarks[*].title)}"
+    }
+  }
+}
+
+data "jamfplatform_cbengine_benchmarks" "cis_updates" {
+  title_regex      = "^CIS "
+  update_available = true
+}
+
+output "cis_update_available" {
+  value = length(data.jamfplatform_cbengine_benchmarks.cis_updates.benchmarks) > 0
+}
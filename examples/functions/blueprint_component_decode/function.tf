@@ -0,0 +1,16 @@
+data "jamfplatform_blueprints_blueprint" "existing" {
+  id = "3c8e1d1a-7f6b-4b0e-9a34-1f2d5c6b7a89"
+}
+
+locals {
+  passcode_component = one([
+    for c in data.jamfplatform_blueprints_blueprint.existing.component : c
+    if c.identifier == "com.jamf.ddm.passcode-settings"
+  ])
+
+  passcode_settings = provider::jamfplatform::blueprint_component_decode("passcode_policy", local.passcode_component.configuration_json)
+}
+
+output "minimum_length" {
+  value = local.passcode_settings.minimum_length
+}
@@ -0,0 +1,16 @@
+locals {
+  passcode_configuration = provider::jamfplatform::blueprint_component_encode("passcode_policy", {
+    require_passcode = true
+    minimum_length   = 12
+  })
+}
+
+resource "jamfplatform_blueprints_blueprint" "passcode" {
+  name          = "Passcode"
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  raw_component {
+    identifier         = "com.jamf.ddm.passcode-settings"
+    configuration_json = local.passcode_configuration
+  }
+}
@@ -0,0 +1,15 @@
+# Unsigned profile
+resource "jamfplatform_blueprints_blueprint" "restrictions" {
+  name          = "Restrictions"
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  legacy_payloads = provider::jamfplatform::mobileconfig_payloads(file("${path.module}/restrictions.mobileconfig"))
+}
+
+# Signed profiles are binary, so read them with filebase64()
+resource "jamfplatform_blueprints_blueprint" "signed_wifi" {
+  name          = "Corporate Wi-Fi"
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  legacy_payloads = provider::jamfplatform::mobileconfig_payloads(filebase64("${path.module}/wifi-signed.mobileconfig"))
+}
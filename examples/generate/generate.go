@@ -0,0 +1,60 @@
+// Copyright 2025 Jamf Software LLC.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/generate"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	output := flag.String("out", "", "file to write the generated configuration to (default: standard output)")
+	blueprints := flag.Bool("blueprints", true, "generate jamfplatform_blueprints_blueprint resources")
+	benchmarks := flag.Bool("benchmarks", true, "generate jamfplatform_cbengine_benchmark resources")
+	flag.Parse()
+
+	clientID := os.Getenv("JAMF_CLIENT_ID")
+	clientSecret := os.Getenv("JAMF_CLIENT_SECRET")
+	baseURL := os.Getenv("JAMF_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://us.apigw.jamf.com"
+	}
+
+	if clientID == "" || clientSecret == "" {
+		return fmt.Errorf("missing required configuration: JAMF_CLIENT_ID, JAMF_CLIENT_SECRET")
+	}
+
+	apiClient := client.NewClient(baseURL, clientID, clientSecret)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("error creating %s: %w", *output, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	opts := generate.Options{
+		Blueprints: *blueprints,
+		Benchmarks: *benchmarks,
+	}
+	if err := generate.Generate(context.Background(), apiClient, w, opts); err != nil {
+		return fmt.Errorf("error generating configuration: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+# Import by blueprint ID
+import {
+  to = jamfplatform_blueprints_blueprint.example
+  id = "013d8b7c-e12d-4086-b309-8fd99058e5b0"
+}
+
+# Import several blueprints by exact name. The import fails if a name matches
+# more than one blueprint.
+import {
+  for_each = toset(["Corp Passcode", "Safari Settings"])
+  to       = jamfplatform_blueprints_blueprint.adopted[each.key]
+  id       = "name:${each.key}"
+}
@@ -1,2 +1,7 @@
 # Copyright 2025 Jamf Software LLC
+# Components with a strongly-typed block are imported into that block; all other
+# components are imported as raw_component blocks with configuration_json.
 terraform import jamfplatform_blueprints_blueprint.example "013d8b7c-e12d-4086-b309-8fd99058e5b0"
+
+# Import by exact blueprint name
+terraform import jamfplatform_blueprints_blueprint.example "name:Corp Passcode"
@@ -74,3 +74,55 @@ resource "jamfplatform_blueprints_blueprint" "legacy_payloads_example" {
     }
   ])
 }
+
+# Multi-step Blueprint
+resource "jamfplatform_blueprints_blueprint" "multi_step" {
+  name        = "Baseline Configuration"
+  description = "Managed by Terraform"
+
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  step {
+    name = "Security"
+
+    passcode_policy {
+      require_passcode = true
+      minimum_length   = 8
+    }
+  }
+
+  step {
+    name = "Updates"
+
+    software_update {
+      deployment_time    = "02:00"
+      enforce_after_days = 7
+    }
+  }
+}
+
+# Raw component with lossless JSON configuration
+resource "jamfplatform_blueprints_blueprint" "raw_json" {
+  name        = "Raw JSON Component"
+  description = "Managed by Terraform"
+
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  raw_component {
+    identifier = "com.jamf.ddm.math-settings"
+    configuration_json = jsonencode({
+      Calculator = {
+        BasicMode      = { Included = true, AddSquareRoot = true }
+        ScientificMode = { Included = true, Enabled = true }
+        ProgrammerMode = { Included = true, Enabled = false }
+        MathNotesMode  = { Included = true, Enabled = true }
+        InputModes     = { Included = true, UnitConversion = true, RPN = false }
+      }
+      SystemBehavior = {
+        Included            = true
+        KeyboardSuggestions = true
+        MathNotes           = false
+      }
+    })
+  }
+}
@@ -0,0 +1,5 @@
+# Import by blueprint ID
+import {
+  to = jamfplatform_blueprints_deployment.example
+  id = "013d8b7c-e12d-4086-b309-8fd99058e5b0"
+}
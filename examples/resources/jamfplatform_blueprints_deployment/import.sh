@@ -0,0 +1,4 @@
+# Copyright 2025 Jamf Software LLC
+# Importing records the most recent deployment of the blueprint without
+# triggering a new one.
+terraform import jamfplatform_blueprints_deployment.example "013d8b7c-e12d-4086-b309-8fd99058e5b0"
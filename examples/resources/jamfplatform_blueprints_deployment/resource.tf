@@ -0,0 +1,25 @@
+# Stage blueprint changes without deploying them
+resource "jamfplatform_blueprints_blueprint" "passcode" {
+  name        = "Corp Passcode"
+  description = "Managed by Terraform"
+  deploy      = false
+
+  device_groups = ["fce3d9a5-8660-42ff-a95e-625e7b53b48a"]
+
+  passcode_policy {
+    require_passcode = true
+  }
+}
+
+# Deploy on demand; changing release triggers a redeployment
+resource "jamfplatform_blueprints_deployment" "passcode" {
+  blueprint_id = jamfplatform_blueprints_blueprint.passcode.id
+
+  triggers = {
+    release = "2025-11"
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
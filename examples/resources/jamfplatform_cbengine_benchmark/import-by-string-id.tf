@@ -0,0 +1,13 @@
+# Import by benchmark ID
+import {
+  to = jamfplatform_cbengine_benchmark.example
+  id = "906ad0ba-57aa-4243-b08b-b7d8e29b0363"
+}
+
+# Import several benchmarks by exact title. The import fails if a title matches
+# more than one benchmark.
+import {
+  for_each = toset(["CIS Level 1", "CIS Level 2"])
+  to       = jamfplatform_cbengine_benchmark.adopted[each.key]
+  id       = "title:${each.key}"
+}
@@ -1,2 +1,5 @@
 # Copyright 2025 Jamf Software LLC
 terraform import jamfplatform_cbengine_benchmark.example "906ad0ba-57aa-4243-b08b-b7d8e29b0363"
+
+# Import by exact benchmark title
+terraform import jamfplatform_cbengine_benchmark.example "title:CIS Level 1"
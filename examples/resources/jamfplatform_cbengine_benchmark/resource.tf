@@ -16,15 +16,14 @@ resource "jamfplatform_cbengine_benchmark" "cis_lvl1" {
     }
   ]
 
-  rules = [
-    for r in data.jamfplatform_cbengine_rules.cis_lvl1.rules : {
-      id      = r.id
+  rules = {
+    for r in data.jamfplatform_cbengine_rules.cis_lvl1.rules : r.id => {
       enabled = r.enabled
     }
-  ]
+  }
 
-  target_device_group = "4a36a1fe-e45a-430d-a966-a4d3ac993577"
-  enforcement_mode    = "MONITOR_AND_ENFORCE"
+  target_device_groups = ["4a36a1fe-e45a-430d-a966-a4d3ac993577"]
+  enforcement_mode     = "MONITOR_AND_ENFORCE"
 }
 
 resource "jamfplatform_cbengine_benchmark" "custom_cis_lvl1" {
@@ -39,17 +38,42 @@ resource "jamfplatform_cbengine_benchmark" "custom_cis_lvl1" {
     }
   ]
 
-  rules = [
-    {
-      id        = "system_settings_time_server_configure"
+  rules = {
+    system_settings_time_server_configure = {
       enabled   = true
       odv_value = "ntp.jamf.com"
-    },
-    {
-      id      = "system_settings_critical_update_install_enforce"
+    }
+    system_settings_critical_update_install_enforce = {
       enabled = true
     }
+  }
+  target_device_groups = ["4a36a1fe-e45a-430d-a966-a4d3ac993577"]
+  enforcement_mode     = "MONITOR"
+}
+
+resource "jamfplatform_cbengine_benchmark" "cis_lvl1_selection" {
+  title              = "CIS Level 1 Benchmark - Baseline Defaults"
+  description        = "All baseline rules except auditing, with a custom time server"
+  source_baseline_id = "cis_lvl1"
+
+  sources = [
+    for s in data.jamfplatform_cbengine_rules.cis_lvl1.sources : {
+      branch   = s.branch
+      revision = s.revision
+    }
   ]
-  target_device_group = "4a36a1fe-e45a-430d-a966-a4d3ac993577"
-  enforcement_mode    = "MONITOR"
+
+  rule_selection = {
+    exclude_sections = ["Auditing"]
+    exclude_rules    = ["system_settings_bluetooth_disable"]
+    odv_overrides = {
+      system_settings_time_server_configure = "ntp.jamf.com"
+    }
+  }
+
+  # Also enable the rules that the selected rules depend on.
+  resolve_dependencies = true
+
+  target_device_groups = ["4a36a1fe-e45a-430d-a966-a4d3ac993577"]
+  enforcement_mode     = "MONITOR"
 }
@@ -176,7 +176,8 @@ func (c *Client) GetBlueprintByIDV1(ctx context.Context, blueprintID string) (*B
 	return &result, nil
 }
 
-// GetBlueprintByNameV1 finds a blueprint by exact name and returns its details
+// GetBlueprintByNameV1 finds a blueprint by exact name and returns its details.
+// It returns an error wrapping ErrAmbiguous when several blueprints share the name.
 func (c *Client) GetBlueprintByNameV1(ctx context.Context, name string) (*BlueprintDetailV1, error) {
 	if name == "" {
 		return nil, fmt.Errorf("name cannot be empty")
@@ -185,12 +186,20 @@ func (c *Client) GetBlueprintByNameV1(ctx context.Context, name string) (*Bluepr
 	if err != nil {
 		return nil, fmt.Errorf("error searching for blueprint by name: %w", err)
 	}
+	var matches []string
 	for _, bp := range blueprints {
 		if bp.Name == name {
-			return c.GetBlueprintByIDV1(ctx, bp.ID)
+			matches = append(matches, bp.ID)
 		}
 	}
-	return nil, fmt.Errorf("blueprint with name '%s' not found", name)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("blueprint with name '%s' %w", name, ErrNotFound)
+	case 1:
+		return c.GetBlueprintByIDV1(ctx, matches[0])
+	default:
+		return nil, fmt.Errorf("blueprint name '%s' %w, it matches %d blueprints: %s", name, ErrAmbiguous, len(matches), strings.Join(matches, ", "))
+	}
 }
 
 // CreateBlueprintV1 creates a new blueprint
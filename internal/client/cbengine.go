@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -234,6 +235,42 @@ func (c *Client) GetCBEngineBenchmarkByIDV2(ctx context.Context, id string) (*CB
 	return &result, nil
 }
 
+// UpdateCBEngineBenchmarkV2 replaces the configuration of an existing benchmark.
+// Like creation, the update is applied asynchronously; callers should poll the
+// benchmark sync state until it settles.
+func (c *Client) UpdateCBEngineBenchmarkV2(ctx context.Context, id string, request *CBEngineBenchmarkRequestV2) (*CBEngineBenchmarkResponseV2, error) {
+	endpoint := fmt.Sprintf("%s/benchmarks/%s", cbEngineV2Prefix, url.PathEscape(id))
+
+	resp, err := c.makeRequest(ctx, "PUT", endpoint, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update benchmark %s: %w", id, err)
+	}
+
+	var result CBEngineBenchmarkResponseV2
+	if err := c.handleAPIResponse(ctx, resp, 202, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AcceptCBEngineBenchmarkUpdateV2 accepts the pending mSCP baseline update for a
+// benchmark (see UpdateAvailable). The update is applied asynchronously.
+func (c *Client) AcceptCBEngineBenchmarkUpdateV2(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("%s/benchmarks/%s/update", cbEngineV2Prefix, url.PathEscape(id))
+
+	resp, err := c.makeRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to accept update for benchmark %s: %w", id, err)
+	}
+
+	if err := c.handleAPIResponse(ctx, resp, 202, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteCBEngineBenchmarkV1 removes a benchmark by ID
 func (c *Client) DeleteCBEngineBenchmarkV1(ctx context.Context, id string) error {
 	endpoint := fmt.Sprintf("%s/benchmarks/%s", cbEngineV1Prefix, url.PathEscape(id))
@@ -250,20 +287,29 @@ func (c *Client) DeleteCBEngineBenchmarkV1(ctx context.Context, id string) error
 	return nil
 }
 
-// GetCBEngineBenchmarkByTitleV2 retrieves a specific benchmark by title
+// GetCBEngineBenchmarkByTitleV2 retrieves a specific benchmark by title.
+// It returns an error wrapping ErrAmbiguous when several benchmarks share the title.
 func (c *Client) GetCBEngineBenchmarkByTitleV2(ctx context.Context, title string) (*CBEngineBenchmarkResponseV2, error) {
 	benchmarks, err := c.GetCBEngineBenchmarksV2(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get benchmarks list: %w", err)
 	}
 
+	var matches []string
 	for _, benchmark := range benchmarks.Benchmarks {
 		if benchmark.Title == title {
-			return c.GetCBEngineBenchmarkByIDV2(ctx, benchmark.ID)
+			matches = append(matches, benchmark.ID)
 		}
 	}
 
-	return nil, fmt.Errorf("benchmark with title '%s' not found", title)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("benchmark with title '%s' %w", title, ErrNotFound)
+	case 1:
+		return c.GetCBEngineBenchmarkByIDV2(ctx, matches[0])
+	default:
+		return nil, fmt.Errorf("benchmark title '%s' %w, it matches %d benchmarks: %s", title, ErrAmbiguous, len(matches), strings.Join(matches, ", "))
+	}
 }
 
 // CBEngine Rule operations
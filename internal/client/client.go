@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // Logger is an interface for logging HTTP requests and responses
@@ -41,21 +42,8 @@ type Client struct {
 	oauthClient *OAuthClient
 	baseURL     string
 	logger      Logger
-}
-
-// ApiError represents an error response from the API
-type ApiError struct {
-	HTTPStatus int     `json:"httpStatus"`
-	TraceID    string  `json:"traceId"`
-	Errors     []Error `json:"errors"`
-}
-
-// Error represents an error response from the API
-type Error struct {
-	ID          string `json:"id,omitempty"`
-	Code        string `json:"code"`
-	Field       string `json:"field"`
-	Description string `json:"description"`
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
 }
 
 // NewClient creates a new Jamf Platform API client.
@@ -75,6 +63,8 @@ func NewClient(baseURL, clientID, clientSecret string) *Client {
 	return &Client{
 		oauthClient: NewOAuthClient(config),
 		baseURL:     baseURL,
+		retryPolicy: DefaultRetryPolicy(),
+		limiter:     newRateLimiter(0, 1, DefaultRetryPolicy().MaxBackoff),
 	}
 }
 
@@ -88,6 +78,21 @@ func (c *Client) SetLogger(logger Logger) {
 	c.logger = logger
 }
 
+// SetRetryPolicy replaces the policy used to retry transient API failures.
+// Its MaxBackoff also caps pauses requested by the API's rate-limit headers.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+	c.limiter.setMaxPause(policy.MaxBackoff)
+}
+
+// SetRateLimit limits the client to requestsPerSecond on average, allowing
+// bursts of up to burst requests. The limit is shared by all goroutines using
+// the client. A non-positive rate removes the local limit, while pauses
+// requested by the API's rate-limit headers are still honoured.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.limiter = newRateLimiter(requestsPerSecond, burst, c.retryPolicy.MaxBackoff)
+}
+
 // SetUserAgent sets the User-Agent header value used for token and API requests.
 func (c *Client) SetUserAgent(ua string) {
 	if c.oauthClient != nil {
@@ -100,7 +105,8 @@ func (c *Client) OAuthClient() *OAuthClient {
 	return c.oauthClient
 }
 
-// makeRequest is a helper method for making authenticated API requests
+// makeRequest is a helper method for making authenticated API requests.
+// Transient failures are retried according to the client's RetryPolicy.
 func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
 	var requestBodyBytes []byte
 
@@ -119,26 +125,50 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 		}
 	}
 
-	if c.logger != nil {
-		c.logger.LogRequest(ctx, method, fullURL, requestBodyBytes)
-	}
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("API request canceled while waiting for rate limiter: %w", err)
+		}
 
-	req, err := c.oauthClient.AuthenticatedRequest(ctx, method, fullURL, requestBodyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authenticated request: %w", err)
-	}
+		if c.logger != nil {
+			c.logger.LogRequest(ctx, method, fullURL, requestBodyBytes)
+		}
 
-	if body != nil {
-		if method == http.MethodPatch {
-			req.Header.Set("Content-Type", "application/merge-patch+json")
-		} else {
-			req.Header.Set("Content-Type", "application/json")
+		resp, err := c.doRequest(ctx, method, fullURL, requestBodyBytes)
+		if err != nil {
+			if !c.retryPolicy.shouldRetry(method, attempt, 0, err) {
+				if attempt > 0 {
+					return nil, fmt.Errorf("API request failed after %d attempts: %w", attempt+1, err)
+				}
+				return nil, fmt.Errorf("API request failed: %w", err)
+			}
+			if sleepErr := sleepContext(ctx, c.retryPolicy.backoff(attempt, 0)); sleepErr != nil {
+				return nil, fmt.Errorf("API request failed: %w", err)
+			}
+			continue
+		}
+
+		c.limiter.observe(resp, time.Now())
+
+		if !c.retryPolicy.shouldRetry(method, attempt, resp.StatusCode, nil) {
+			return resp, nil
+		}
+
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		c.discardResponse(ctx, resp)
+
+		if err := sleepContext(ctx, c.retryPolicy.backoff(attempt, retryAfter)); err != nil {
+			return nil, fmt.Errorf("API request canceled while waiting to retry: %w", err)
 		}
 	}
+}
 
+// doRequest performs a single authenticated request, refreshing the token
+// once if the API responds with 401.
+func (c *Client) doRequest(ctx context.Context, method, fullURL string, requestBodyBytes []byte) (*http.Response, error) {
 	resp, err := c.oauthClient.Do(ctx, method, fullURL, requestBodyBytes)
 	if err != nil {
-		return nil, fmt.Errorf("API request failed: %w", err)
+		return nil, err
 	}
 
 	if resp.StatusCode == http.StatusUnauthorized {
@@ -150,13 +180,24 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, body
 
 		resp, err = c.oauthClient.Do(ctx, method, fullURL, requestBodyBytes)
 		if err != nil {
-			return nil, fmt.Errorf("API request failed on retry: %w", err)
+			return nil, fmt.Errorf("retry after 401 failed: %w", err)
 		}
 	}
 
 	return resp, nil
 }
 
+// discardResponse logs and closes a response that is about to be retried.
+func (c *Client) discardResponse(ctx context.Context, resp *http.Response) {
+	body, _ := io.ReadAll(resp.Body)
+	if c.logger != nil {
+		c.logger.LogResponse(ctx, resp.StatusCode, body)
+	}
+	if err := resp.Body.Close(); err != nil && c.oauthClient.logger != nil {
+		c.oauthClient.logger.Printf("warning: error closing response body: %v", err)
+	}
+}
+
 // handleAPIResponse processes API responses and handles common error cases
 func (c *Client) handleAPIResponse(ctx context.Context, resp *http.Response, expectedStatus int, result interface{}) error {
 	defer func() {
@@ -179,22 +220,7 @@ func (c *Client) handleAPIResponse(ctx context.Context, resp *http.Response, exp
 	}
 
 	if resp.StatusCode != expectedStatus {
-		requestInfo := fmt.Sprintf("method=%s, url=%s", resp.Request.Method, resp.Request.URL.String())
-
-		var apiErr ApiError
-		if err := json.Unmarshal(body, &apiErr); err == nil && len(apiErr.Errors) > 0 {
-			var details []string
-			for _, e := range apiErr.Errors {
-				details = append(details, fmt.Sprintf("[%s] %s: %s", e.Code, e.Field, e.Description))
-			}
-			return fmt.Errorf("API request failed with status %d, traceId %s (%s): %s", apiErr.HTTPStatus, apiErr.TraceID, requestInfo, details)
-		}
-
-		if resp.StatusCode >= 500 {
-			return fmt.Errorf("server error (status %d) for %s: %s - this appears to be a server-side issue, consider retrying or checking server logs", resp.StatusCode, requestInfo, string(body))
-		}
-
-		return fmt.Errorf("API request failed with status %d (%s): %s", resp.StatusCode, requestInfo, string(body))
+		return newAPIError(resp, body)
 	}
 
 	if result != nil {
@@ -0,0 +1,137 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned when a lookup that is not backed by a single API
+// call (e.g. finding a blueprint by name) does not match any object.
+var ErrNotFound = errors.New("not found")
+
+// ErrAmbiguous is returned when a lookup that is not backed by a single API
+// call (e.g. finding a blueprint by name) matches more than one object.
+var ErrAmbiguous = errors.New("is ambiguous")
+
+// ApiError represents an error response from the API. It is returned by every
+// client method when the API responds with an unexpected status code and can
+// be inspected with errors.As or the IsNotFound, IsConflict, IsRateLimited,
+// IsInternalServerError and IsServerError helpers.
+type ApiError struct {
+	HTTPStatus int     `json:"httpStatus"`
+	TraceID    string  `json:"traceId"`
+	Errors     []Error `json:"errors"`
+
+	// Method and URL identify the request that produced the error.
+	Method string `json:"-"`
+	URL    string `json:"-"`
+	// Body holds the raw response body when it could not be decoded into Errors.
+	Body string `json:"-"`
+}
+
+// Error represents an error response from the API
+type Error struct {
+	ID          string `json:"id,omitempty"`
+	Code        string `json:"code"`
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// newAPIError builds an ApiError from an unexpected HTTP response and its body.
+func newAPIError(resp *http.Response, body []byte) *ApiError {
+	apiErr := &ApiError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || len(apiErr.Errors) == 0 {
+		apiErr.Errors = nil
+		apiErr.Body = string(body)
+	}
+	apiErr.HTTPStatus = resp.StatusCode
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.URL = resp.Request.URL.String()
+		}
+	}
+	return apiErr
+}
+
+// Error implements the error interface.
+func (e *ApiError) Error() string {
+	requestInfo := fmt.Sprintf("method=%s, url=%s", e.Method, e.URL)
+
+	if len(e.Errors) > 0 {
+		details := make([]string, 0, len(e.Errors))
+		for _, d := range e.Errors {
+			details = append(details, fmt.Sprintf("[%s] %s: %s", d.Code, d.Field, d.Description))
+		}
+		return fmt.Sprintf("API request failed with status %d, traceId %s (%s): %s", e.HTTPStatus, e.TraceID, requestInfo, strings.Join(details, "; "))
+	}
+
+	if e.HTTPStatus >= 500 {
+		return fmt.Sprintf("server error (status %d) for %s: %s - this appears to be a server-side issue, consider retrying or checking server logs", e.HTTPStatus, requestInfo, e.Body)
+	}
+
+	return fmt.Sprintf("API request failed with status %d (%s): %s", e.HTTPStatus, requestInfo, e.Body)
+}
+
+// HasCode reports whether any of the decoded error details carries the given code.
+func (e *ApiError) HasCode(code string) bool {
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// AsAPIError returns the ApiError wrapped in err, if any.
+func AsAPIError(err error) (*ApiError, bool) {
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err indicates that the requested object does not exist.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	return ok && (apiErr.HTTPStatus == http.StatusNotFound || apiErr.HasCode("NOT_FOUND"))
+}
+
+// IsAmbiguous reports whether err indicates that a lookup matched more than one object.
+func IsAmbiguous(err error) bool {
+	return errors.Is(err, ErrAmbiguous)
+}
+
+// IsConflict reports whether err is a 409 Conflict response.
+func IsConflict(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.HTTPStatus == http.StatusConflict
+}
+
+// IsRateLimited reports whether err is a 429 Too Many Requests response.
+func IsRateLimited(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.HTTPStatus == http.StatusTooManyRequests
+}
+
+// IsInternalServerError reports whether err is a 500 Internal Server Error
+// response. Unlike gateway errors, the request may have been processed.
+func IsInternalServerError(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.HTTPStatus == http.StatusInternalServerError
+}
+
+// IsServerError reports whether err is a 5xx response.
+func IsServerError(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.HTTPStatus >= 500 && apiErr.HTTPStatus <= 599
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServerErrorHelpers(t *testing.T) {
+	tests := []struct {
+		status       int
+		wantInternal bool
+		wantServer   bool
+	}{
+		{status: http.StatusBadRequest},
+		{status: http.StatusInternalServerError, wantInternal: true, wantServer: true},
+		{status: http.StatusBadGateway, wantServer: true},
+		{status: http.StatusServiceUnavailable, wantServer: true},
+		{status: http.StatusGatewayTimeout, wantServer: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("delete failed: %w", &ApiError{HTTPStatus: tt.status})
+			if got := IsInternalServerError(err); got != tt.wantInternal {
+				t.Errorf("IsInternalServerError() = %t, want %t", got, tt.wantInternal)
+			}
+			if got := IsServerError(err); got != tt.wantServer {
+				t.Errorf("IsServerError() = %t, want %t", got, tt.wantServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every request made through a
+// Client. Besides the configured rate it honours pauses learned from the
+// gateway's rate-limit headers so that parallel operations back off together.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	maxPause    time.Duration
+}
+
+// newRateLimiter creates a limiter allowing requestsPerSecond on average with
+// bursts of up to burst requests. A non-positive rate disables the local
+// limit; pauses signalled by the API still apply, capped at maxPause when it
+// is positive.
+func newRateLimiter(requestsPerSecond float64, burst int, maxPause time.Duration) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:     requestsPerSecond,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+		maxPause: maxPause,
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns zero, otherwise it
+// returns how long the caller should wait before trying again.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// pause stops all requests for d after now, or for maxPause if d is longer.
+func (l *rateLimiter) pause(now time.Time, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxPause > 0 && d > l.maxPause {
+		d = l.maxPause
+	}
+	if t := now.Add(d); t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
+
+// setMaxPause caps the pauses requested by the API's rate-limit headers.
+func (l *rateLimiter) setMaxPause(maxPause time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.maxPause = maxPause
+}
+
+// observe adapts the limiter to the rate-limit information in a response.
+// A 429 with Retry-After, or an exhausted quota reported through
+// X-RateLimit-Remaining / RateLimit-Remaining, pauses every caller until
+// the advertised reset time, but no longer than maxPause.
+func (l *rateLimiter) observe(resp *http.Response, now time.Time) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), now); retryAfter > 0 {
+			l.pause(now, retryAfter)
+			return
+		}
+	}
+
+	remaining := headerValue(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if remaining == "" {
+		return
+	}
+	if n, err := strconv.Atoi(remaining); err != nil || n > 0 {
+		return
+	}
+	if reset := parseRateLimitReset(headerValue(resp.Header, "X-RateLimit-Reset", "RateLimit-Reset"), now); reset > 0 {
+		l.pause(now, reset)
+	}
+}
+
+// headerValue returns the first non-empty header among names.
+func headerValue(h http.Header, names ...string) string {
+	for _, name := range names {
+		if v := h.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseRateLimitReset interprets a rate-limit reset header, which gateways
+// send either as seconds until reset or as a Unix timestamp.
+func parseRateLimitReset(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	// Values this large can only be epoch timestamps.
+	if seconds > 1_000_000_000 {
+		if d := time.Unix(seconds, 0).Sub(now); d > 0 {
+			return d
+		}
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
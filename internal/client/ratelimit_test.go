@@ -0,0 +1,201 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(2, 2, 0)
+	l.last = start
+
+	steps := []struct {
+		name  string
+		after time.Duration
+		want  time.Duration
+	}{
+		{name: "first burst token", after: 0, want: 0},
+		{name: "second burst token", after: 0, want: 0},
+		{name: "bucket empty", after: 0, want: 500 * time.Millisecond},
+		{name: "partially refilled", after: 250 * time.Millisecond, want: 250 * time.Millisecond},
+		{name: "refilled", after: 500 * time.Millisecond, want: 0},
+		{name: "refill capped at burst", after: time.Minute, want: 0},
+		{name: "second token after refill", after: 0, want: 0},
+		{name: "empty after burst", after: 0, want: 500 * time.Millisecond},
+	}
+
+	now := start
+	for _, step := range steps {
+		now = now.Add(step.after)
+		if got := l.reserve(now); got != step.want {
+			t.Errorf("%s: reserve() = %s, want %s", step.name, got, step.want)
+		}
+	}
+}
+
+func TestRateLimiterReserveUnlimited(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(0, 1, 0)
+	for i := 0; i < 100; i++ {
+		if got := l.reserve(now); got != 0 {
+			t.Fatalf("reserve() = %s on call %d, want 0", got, i)
+		}
+	}
+
+	l.pause(now, 3*time.Second)
+	if got := l.reserve(now); got != 3*time.Second {
+		t.Errorf("reserve() while paused = %s, want 3s", got)
+	}
+	if got := l.reserve(now.Add(3 * time.Second)); got != 0 {
+		t.Errorf("reserve() after pause = %s, want 0", got)
+	}
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		maxPause time.Duration
+		want     time.Duration
+	}{
+		{
+			name:   "too many requests with retry after",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": []string{"5"}},
+			want:   5 * time.Second,
+		},
+		{
+			name:     "oversized retry after capped",
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"86400"}},
+			maxPause: 30 * time.Second,
+			want:     30 * time.Second,
+		},
+		{
+			name:     "oversized reset capped",
+			status:   http.StatusOK,
+			header:   http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{"3600"}},
+			maxPause: 30 * time.Second,
+			want:     30 * time.Second,
+		},
+		{
+			name:     "retry after within cap",
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"5"}},
+			maxPause: 30 * time.Second,
+			want:     5 * time.Second,
+		},
+		{
+			name:   "retry after ignored on success",
+			status: http.StatusOK,
+			header: http.Header{"Retry-After": []string{"5"}},
+		},
+		{
+			name:   "quota remaining",
+			status: http.StatusOK,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"3"}, "X-Ratelimit-Reset": []string{"10"}},
+		},
+		{
+			name:   "quota exhausted with reset seconds",
+			status: http.StatusOK,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{"10"}},
+			want:   10 * time.Second,
+		},
+		{
+			name:   "quota exhausted with reset timestamp",
+			status: http.StatusOK,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{strconv.FormatInt(now.Add(42*time.Second).Unix(), 10)},
+			},
+			want: 42 * time.Second,
+		},
+		{
+			name:   "quota exhausted with past reset timestamp",
+			status: http.StatusOK,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)},
+			},
+		},
+		{
+			name:   "standard rate limit headers",
+			status: http.StatusOK,
+			header: http.Header{"Ratelimit-Remaining": []string{"0"}, "Ratelimit-Reset": []string{"7"}},
+			want:   7 * time.Second,
+		},
+		{
+			name:   "too many requests without retry after uses reset",
+			status: http.StatusTooManyRequests,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{"4"}},
+			want:   4 * time.Second,
+		},
+		{
+			name:   "invalid remaining",
+			status: http.StatusOK,
+			header: http.Header{"X-Ratelimit-Remaining": []string{"none"}, "X-Ratelimit-Reset": []string{"10"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(0, 1, tt.maxPause)
+			l.observe(&http.Response{StatusCode: tt.status, Header: tt.header}, now)
+
+			if got := l.reserve(now); got != tt.want {
+				t.Errorf("pause = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "30", want: 30 * time.Second},
+		{name: "largest seconds value", value: "1000000000", want: 1_000_000_000 * time.Second},
+		{name: "epoch timestamp", value: strconv.FormatInt(now.Add(time.Minute).Unix(), 10), want: time.Minute},
+		{name: "past epoch timestamp", value: strconv.FormatInt(now.Add(-time.Minute).Unix(), 10), want: 0},
+		{name: "zero", value: "0", want: 0},
+		{name: "negative", value: "-5", want: 0},
+		{name: "invalid", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRateLimitReset(tt.value, now); got != tt.want {
+				t.Errorf("parseRateLimitReset(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientRateLimitPauseFollowsRetryPolicy(t *testing.T) {
+	c := NewClient("https://example.invalid", "id", "secret")
+	if got := c.limiter.maxPause; got != DefaultRetryPolicy().MaxBackoff {
+		t.Errorf("default max pause = %s, want %s", got, DefaultRetryPolicy().MaxBackoff)
+	}
+
+	policy := DefaultRetryPolicy()
+	policy.MaxBackoff = 10 * time.Second
+	c.SetRetryPolicy(policy)
+	c.SetRateLimit(5, 5)
+	if got := c.limiter.maxPause; got != 10*time.Second {
+		t.Errorf("max pause after SetRateLimit = %s, want 10s", got)
+	}
+}
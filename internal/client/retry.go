@@ -0,0 +1,145 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient error (429, 502, 503, 504 or a dropped connection).
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt. Zero disables retries.
+	MaxRetries int
+	// BaseBackoff is the wait before the first retry; it doubles on every subsequent retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between attempts, including waits requested via Retry-After.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0-1) of each backoff that is randomised to spread out retries.
+	Jitter float64
+	// RetryNonIdempotent allows POST and PATCH requests to be retried on 502, 503,
+	// 504 and connection errors. 429 responses are always retried because the
+	// gateway rejected the request before it was processed.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:  3,
+		BaseBackoff: 1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// isIdempotentMethod reports whether a request using method can be safely repeated.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether statusCode indicates a transient gateway failure.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error is likely to succeed on retry.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// shouldRetry decides whether another attempt is allowed for the given method and outcome.
+func (p RetryPolicy) shouldRetry(method string, attempt int, statusCode int, err error) bool {
+	if attempt >= p.MaxRetries {
+		return false
+	}
+	if err != nil {
+		return isRetryableError(err) && (isIdempotentMethod(method) || p.RetryNonIdempotent)
+	}
+	if !isRetryableStatus(statusCode) {
+		return false
+	}
+	return statusCode == http.StatusTooManyRequests || isIdempotentMethod(method) || p.RetryNonIdempotent
+}
+
+// backoff returns the wait before retry number attempt (starting at 0). A
+// positive retryAfter taken from the response overrides the exponential value.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return retryAfter
+	}
+
+	wait := p.BaseBackoff
+	for i := 0; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 && wait > 0 {
+		wait -= time.Duration(rand.Float64() * p.Jitter * float64(wait))
+	}
+	return wait
+}
+
+// parseRetryAfter interprets a Retry-After header given either as delay
+// seconds or as an HTTP date. It returns zero when the header is absent or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 Jamf Software LLC.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient starts a server that issues tokens on /auth/token and answers
+// every other request with handler, and returns a client using policy.
+func newTestClient(t *testing.T, policy RetryPolicy, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/token" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(server.URL, "id", "secret")
+	c.SetHTTPClient(server.Client())
+	c.SetRetryPolicy(policy)
+	return c
+}
+
+// statusSequence answers the nth request with statuses[n], repeating the last
+// status once the sequence is exhausted, and counts the requests in calls.
+func statusSequence(calls *atomic.Int32, header http.Header, statuses ...int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		if n >= len(statuses) {
+			n = len(statuses) - 1
+		}
+		for name, values := range header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(statuses[n])
+	}
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:  3,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "7", want: 7 * time.Second},
+		{name: "negative seconds", value: "-1", want: 0},
+		{name: "http date", value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second},
+		{name: "past http date", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{name: "invalid", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeRequestHonoursRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter func() string
+		minWait    time.Duration
+	}{
+		{
+			name:       "seconds",
+			retryAfter: func() string { return "1" },
+			minWait:    time.Second,
+		},
+		{
+			// HTTP dates have second precision, so the wait is at least one second.
+			name:       "http date",
+			retryAfter: func() string { return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat) },
+			minWait:    time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			header := http.Header{"Retry-After": []string{tt.retryAfter()}}
+			c := newTestClient(t, testRetryPolicy(), statusSequence(&calls, header, http.StatusServiceUnavailable, http.StatusOK))
+
+			start := time.Now()
+			resp, err := c.makeRequest(context.Background(), http.MethodGet, "/api/test", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			if got := calls.Load(); got != 2 {
+				t.Errorf("requests = %d, want 2", got)
+			}
+			if elapsed := time.Since(start); elapsed < tt.minWait {
+				t.Errorf("retried after %s, want at least %s", elapsed, tt.minWait)
+			}
+		})
+	}
+}
+
+func TestMakeRequestNonIdempotent(t *testing.T) {
+	tests := []struct {
+		name               string
+		status             int
+		retryNonIdempotent bool
+		wantCalls          int32
+		wantStatus         int
+	}{
+		{
+			name:       "server error not retried",
+			status:     http.StatusServiceUnavailable,
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "too many requests retried",
+			status:     http.StatusTooManyRequests,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:               "server error retried when allowed",
+			status:             http.StatusServiceUnavailable,
+			retryNonIdempotent: true,
+			wantCalls:          2,
+			wantStatus:         http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := testRetryPolicy()
+			policy.RetryNonIdempotent = tt.retryNonIdempotent
+
+			var calls atomic.Int32
+			c := newTestClient(t, policy, statusSequence(&calls, nil, tt.status, http.StatusOK))
+
+			resp, err := c.makeRequest(context.Background(), http.MethodPost, "/api/test", map[string]string{"name": "test"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("requests = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestMakeRequestStopsAfterMaxRetries(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MaxRetries = 2
+
+	var calls atomic.Int32
+	c := newTestClient(t, policy, statusSequence(&calls, nil, http.StatusBadGateway))
+
+	resp, err := c.makeRequest(context.Background(), http.MethodGet, "/api/test", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusBadGateway)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestMakeRequestCanceledWhileWaiting(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.BaseBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+
+	var calls atomic.Int32
+	c := newTestClient(t, policy, statusSequence(&calls, nil, http.StatusServiceUnavailable))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.makeRequest(ctx, http.MethodGet, "/api/test", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprintcomponent
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint/components"
+)
+
+// lookupComponent returns the typed component registered for an identifier or block name.
+func lookupComponent(name string) (components.ComponentRegistry, error) {
+	registry, ok := components.LookupComponentRegistry(name)
+	if ok {
+		return registry, nil
+	}
+
+	supported := make([]string, 0, len(components.CommonComponentRegistries))
+	for _, r := range components.CommonComponentRegistries {
+		supported = append(supported, r.BlockName())
+	}
+	sort.Strings(supported)
+	return components.ComponentRegistry{}, fmt.Errorf("unsupported component %q, expected a component identifier or one of: %v", name, supported)
+}
+
+// componentObjectType returns the object type of a typed component block.
+func componentObjectType(registry components.ComponentRegistry) basetypes.ObjectType {
+	return registry.Schema().Type().(basetypes.ObjectType)
+}
+
+// componentFromSettings builds a typed component from an object or map value
+// with the same attributes as the component block.
+func componentFromSettings(ctx context.Context, registry components.ComponentRegistry, settings attr.Value) (components.ComponentConverter, error) {
+	objectType := componentObjectType(registry)
+
+	in, err := settings.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if in.IsNull() {
+		return nil, fmt.Errorf("settings must not be null")
+	}
+	if !in.IsFullyKnown() {
+		return nil, fmt.Errorf("settings must be fully known")
+	}
+
+	coerced, err := coerceValue(in, objectType.TerraformType(ctx), "")
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := objectType.ValueFromTerraform(ctx, coerced)
+	if err != nil {
+		return nil, err
+	}
+
+	component := registry.NewComponent()
+	diags := value.(types.Object).As(ctx, component, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not read settings: %s", diags.Errors()[0].Detail())
+	}
+	return component, nil
+}
+
+// coerceValue converts a value of any type to the target type the way Terraform
+// converts module inputs. Missing object attributes become null and attributes
+// that the target does not define are rejected.
+func coerceValue(in tftypes.Value, target tftypes.Type, path string) (tftypes.Value, error) {
+	if in.IsNull() {
+		return tftypes.NewValue(target, nil), nil
+	}
+
+	switch t := target.(type) {
+	case tftypes.Object:
+		if !in.Type().Is(tftypes.Object{}) && !in.Type().Is(tftypes.Map{}) {
+			return tftypes.Value{}, fmt.Errorf("%s must be an object", pathName(path))
+		}
+		var attrs map[string]tftypes.Value
+		if err := in.As(&attrs); err != nil {
+			return tftypes.Value{}, err
+		}
+		for name := range attrs {
+			if _, ok := t.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("unsupported attribute %q", joinPath(path, name))
+			}
+		}
+		values := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attrType := range t.AttributeTypes {
+			attrValue, ok := attrs[name]
+			if !ok {
+				values[name] = tftypes.NewValue(attrType, nil)
+				continue
+			}
+			converted, err := coerceValue(attrValue, attrType, joinPath(path, name))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			values[name] = converted
+		}
+		return tftypes.NewValue(t, values), nil
+
+	case tftypes.List, tftypes.Set:
+		if !in.Type().Is(tftypes.List{}) && !in.Type().Is(tftypes.Set{}) && !in.Type().Is(tftypes.Tuple{}) {
+			return tftypes.Value{}, fmt.Errorf("%s must be a list", pathName(path))
+		}
+		var elems []tftypes.Value
+		if err := in.As(&elems); err != nil {
+			return tftypes.Value{}, err
+		}
+		var elemType tftypes.Type
+		if list, ok := t.(tftypes.List); ok {
+			elemType = list.ElementType
+		} else {
+			elemType = t.(tftypes.Set).ElementType
+		}
+		values := make([]tftypes.Value, len(elems))
+		for i, elem := range elems {
+			converted, err := coerceValue(elem, elemType, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			values[i] = converted
+		}
+		return tftypes.NewValue(t, values), nil
+	}
+
+	if in.Type().Equal(target) {
+		return in, nil
+	}
+	return coercePrimitive(in, target, path)
+}
+
+// coercePrimitive converts between strings, numbers and bools.
+func coercePrimitive(in tftypes.Value, target tftypes.Type, path string) (tftypes.Value, error) {
+	switch {
+	case target.Equal(tftypes.String):
+		switch {
+		case in.Type().Equal(tftypes.Number):
+			var n big.Float
+			if err := in.As(&n); err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(tftypes.String, n.Text('f', -1)), nil
+		case in.Type().Equal(tftypes.Bool):
+			var b bool
+			if err := in.As(&b); err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(tftypes.String, strconv.FormatBool(b)), nil
+		}
+	case target.Equal(tftypes.Number) && in.Type().Equal(tftypes.String):
+		var s string
+		if err := in.As(&s); err != nil {
+			return tftypes.Value{}, err
+		}
+		n, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s must be a number, got %q", pathName(path), s)
+		}
+		return tftypes.NewValue(tftypes.Number, n), nil
+	case target.Equal(tftypes.Bool) && in.Type().Equal(tftypes.String):
+		var s string
+		if err := in.As(&s); err != nil {
+			return tftypes.Value{}, err
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s must be a bool, got %q", pathName(path), s)
+		}
+		return tftypes.NewValue(tftypes.Bool, b), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("%s must be a %s", pathName(path), friendlyTypeName(target))
+}
+
+// friendlyTypeName returns the Terraform type name used in error messages.
+func friendlyTypeName(t tftypes.Type) string {
+	switch {
+	case t.Equal(tftypes.String):
+		return "string"
+	case t.Equal(tftypes.Number):
+		return "number"
+	case t.Equal(tftypes.Bool):
+		return "bool"
+	default:
+		return t.String()
+	}
+}
+
+// joinPath appends an attribute name to a path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// pathName describes a path in error messages.
+func pathName(path string) string {
+	if path == "" {
+		return "settings"
+	}
+	return fmt.Sprintf("attribute %q", path)
+}
@@ -0,0 +1,341 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprintcomponent
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoerceValue(t *testing.T) {
+	target := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":    tftypes.String,
+		"count":   tftypes.Number,
+		"enabled": tftypes.Bool,
+		"items": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"token": tftypes.String,
+		}}},
+	}}
+	item := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"token": tftypes.String}}
+
+	// object builds an input object with the given attributes.
+	object := func(values map[string]tftypes.Value) tftypes.Value {
+		attrTypes := make(map[string]tftypes.Type, len(values))
+		for name, value := range values {
+			attrTypes[name] = value.Type()
+		}
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, values)
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	tests := []struct {
+		name      string
+		in        tftypes.Value
+		want      tftypes.Value
+		wantError string
+	}{
+		{
+			name: "exact types",
+			in: object(map[string]tftypes.Value{
+				"name":    str("a"),
+				"count":   tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"items":   tftypes.NewValue(tftypes.List{ElementType: item}, []tftypes.Value{tftypes.NewValue(item, map[string]tftypes.Value{"token": str("t")})}),
+			}),
+			want: tftypes.NewValue(target, map[string]tftypes.Value{
+				"name":    str("a"),
+				"count":   tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"items":   tftypes.NewValue(target.AttributeTypes["items"], []tftypes.Value{tftypes.NewValue(item, map[string]tftypes.Value{"token": str("t")})}),
+			}),
+		},
+		{
+			name: "missing attributes become null",
+			in:   object(map[string]tftypes.Value{"name": str("a")}),
+			want: tftypes.NewValue(target, map[string]tftypes.Value{
+				"name":    str("a"),
+				"count":   tftypes.NewValue(tftypes.Number, nil),
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"items":   tftypes.NewValue(target.AttributeTypes["items"], nil),
+			}),
+		},
+		{
+			name: "primitive conversions",
+			in: object(map[string]tftypes.Value{
+				"name":    tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"count":   str("12"),
+				"enabled": str("false"),
+			}),
+			want: tftypes.NewValue(target, map[string]tftypes.Value{
+				"name":    str("1.5"),
+				"count":   tftypes.NewValue(tftypes.Number, big.NewFloat(12)),
+				"enabled": tftypes.NewValue(tftypes.Bool, false),
+				"items":   tftypes.NewValue(target.AttributeTypes["items"], nil),
+			}),
+		},
+		{
+			name: "tuple of objects into list",
+			in: object(map[string]tftypes.Value{
+				"items": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}}},
+					[]tftypes.Value{object(map[string]tftypes.Value{})}),
+			}),
+			want: tftypes.NewValue(target, map[string]tftypes.Value{
+				"name":    tftypes.NewValue(tftypes.String, nil),
+				"count":   tftypes.NewValue(tftypes.Number, nil),
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"items": tftypes.NewValue(target.AttributeTypes["items"], []tftypes.Value{
+					tftypes.NewValue(item, map[string]tftypes.Value{"token": tftypes.NewValue(tftypes.String, nil)}),
+				}),
+			}),
+		},
+		{
+			name:      "unsupported attribute",
+			in:        object(map[string]tftypes.Value{"other": str("a")}),
+			wantError: `unsupported attribute "other"`,
+		},
+		{
+			name: "unsupported nested attribute",
+			in: object(map[string]tftypes.Value{
+				"items": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.Object{AttributeTypes: map[string]tftypes.Type{"other": tftypes.String}}}},
+					[]tftypes.Value{object(map[string]tftypes.Value{"other": str("a")})}),
+			}),
+			wantError: `unsupported attribute "items[0].other"`,
+		},
+		{
+			name:      "invalid number",
+			in:        object(map[string]tftypes.Value{"count": str("many")}),
+			wantError: `attribute "count" must be a number, got "many"`,
+		},
+		{
+			name:      "invalid bool",
+			in:        object(map[string]tftypes.Value{"enabled": str("yes please")}),
+			wantError: `attribute "enabled" must be a bool, got "yes please"`,
+		},
+		{
+			name:      "list instead of string",
+			in:        object(map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{})}),
+			wantError: `attribute "name" must be a string`,
+		},
+		{
+			name:      "string instead of list",
+			in:        object(map[string]tftypes.Value{"items": str("a")}),
+			wantError: `attribute "items" must be a list`,
+		},
+		{
+			name:      "settings not an object",
+			in:        str("a"),
+			wantError: "settings must be an object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coerceValue(tt.in, target, "")
+
+			if tt.wantError != "" {
+				if err == nil {
+					t.Fatalf("coerceValue() = %s, want error %q", got, tt.wantError)
+				}
+				if !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("error = %q, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diffs, err := got.Diff(tt.want); err != nil || len(diffs) > 0 {
+				t.Errorf("coerceValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// runEncode calls blueprint_component_encode.
+func runEncode(ctx context.Context, component string, settings attr.Value) (string, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(component), types.DynamicValue(settings)})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewEncodeFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+// runDecode calls blueprint_component_decode.
+func runDecode(ctx context.Context, component, configuration string) (types.Object, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(component), types.StringValue(configuration)})}
+	resp := function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+	NewDecodeFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		return types.Object{}, resp.Error
+	}
+	return resp.Result.Value().(types.Dynamic).UnderlyingValue().(types.Object), nil
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		component     string
+		settings      attr.Value
+		configuration string
+	}{
+		{
+			name:      "passcode policy by block name",
+			component: "passcode_policy",
+			settings: types.ObjectValueMust(
+				map[string]attr.Type{"require_passcode": types.BoolType, "minimum_length": types.NumberType},
+				map[string]attr.Value{"require_passcode": types.BoolValue(true), "minimum_length": types.NumberValue(big.NewFloat(12))},
+			),
+			configuration: `{"MinimumLength":12,"RequirePasscode":true}`,
+		},
+		{
+			name:      "passcode policy by identifier",
+			component: "com.jamf.ddm.passcode-settings",
+			settings: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"change_at_next_auth":     types.StringValue("false"),
+				"maximum_failed_attempts": types.StringValue("5"),
+			}),
+			configuration: `{"ChangeAtNextAuth":false,"MaximumFailedAttempts":5}`,
+		},
+		{
+			name:      "nested list block",
+			component: "software_update_settings",
+			settings: types.ObjectValueMust(
+				map[string]attr.Type{
+					"automatic_download": types.StringType,
+					"beta_offer_programs": types.TupleType{ElemTypes: []attr.Type{
+						types.ObjectType{AttrTypes: map[string]attr.Type{"token": types.StringType, "description": types.StringType}},
+					}},
+				},
+				map[string]attr.Value{
+					"automatic_download": types.StringValue("AlwaysOn"),
+					"beta_offer_programs": types.TupleValueMust(
+						[]attr.Type{types.ObjectType{AttrTypes: map[string]attr.Type{"token": types.StringType, "description": types.StringType}}},
+						[]attr.Value{types.ObjectValueMust(
+							map[string]attr.Type{"token": types.StringType, "description": types.StringType},
+							map[string]attr.Value{"token": types.StringValue("abc"), "description": types.StringValue("Beta")},
+						)},
+					),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, funcErr := runEncode(ctx, tt.component, tt.settings)
+			if funcErr != nil {
+				t.Fatalf("encode failed: %s", funcErr.Text)
+			}
+			if tt.configuration != "" && encoded != tt.configuration {
+				t.Errorf("encoded = %s, want %s", encoded, tt.configuration)
+			}
+
+			decoded, funcErr := runDecode(ctx, tt.component, encoded)
+			if funcErr != nil {
+				t.Fatalf("decode failed: %s", funcErr.Text)
+			}
+
+			// Decoding returns every attribute of the block, so compare against
+			// the settings after conversion to the block type.
+			registry, err := lookupComponent(tt.component)
+			if err != nil {
+				t.Fatal(err)
+			}
+			component, err := componentFromSettings(ctx, registry, tt.settings)
+			if err != nil {
+				t.Fatalf("could not convert settings: %v", err)
+			}
+			want, diags := types.ObjectValueFrom(ctx, componentObjectType(registry).AttrTypes, component)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !decoded.Equal(want) {
+				t.Errorf("decoded = %s, want %s", decoded, want)
+			}
+
+			reencoded, funcErr := runEncode(ctx, tt.component, decoded)
+			if funcErr != nil {
+				t.Fatalf("re-encode failed: %s", funcErr.Text)
+			}
+			var first, second interface{}
+			if err := json.Unmarshal([]byte(encoded), &first); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(reencoded), &second); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("re-encoded = %s, want %s", reencoded, encoded)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeErrors(t *testing.T) {
+	ctx := context.Background()
+	settings := types.ObjectValueMust(map[string]attr.Type{"minimum_length": types.StringType}, map[string]attr.Value{"minimum_length": types.StringValue("twelve")})
+
+	tests := []struct {
+		name      string
+		run       func() *function.FuncError
+		wantError string
+	}{
+		{
+			name: "encode unknown component",
+			run: func() *function.FuncError {
+				_, err := runEncode(ctx, "com.example.unknown", settings)
+				return err
+			},
+			wantError: `unsupported component "com.example.unknown"`,
+		},
+		{
+			name: "encode invalid settings",
+			run: func() *function.FuncError {
+				_, err := runEncode(ctx, "passcode_policy", settings)
+				return err
+			},
+			wantError: `attribute "minimum_length" must be a number, got "twelve"`,
+		},
+		{
+			name: "encode null settings",
+			run: func() *function.FuncError {
+				_, err := runEncode(ctx, "passcode_policy", types.ObjectNull(map[string]attr.Type{}))
+				return err
+			},
+			wantError: "settings must not be null",
+		},
+		{
+			name: "decode invalid JSON",
+			run: func() *function.FuncError {
+				_, err := runDecode(ctx, "passcode_policy", `[1, 2]`)
+				return err
+			},
+			wantError: "configuration must be a JSON object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+			if err == nil {
+				t.Fatalf("expected error %q", tt.wantError)
+			}
+			if !strings.Contains(err.Text, tt.wantError) {
+				t.Errorf("error = %q, want %q", err.Text, tt.wantError)
+			}
+		})
+	}
+}
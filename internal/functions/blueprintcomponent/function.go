@@ -0,0 +1,166 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprintcomponent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &EncodeFunction{}
+	_ function.Function = &DecodeFunction{}
+)
+
+// componentParameter is the first parameter of both functions.
+var componentParameter = function.StringParameter{
+	Name:        "component",
+	Description: "Component identifier, for example com.jamf.ddm.passcode-settings, or the name of its block on jamfplatform_blueprints_blueprint, for example passcode_policy.",
+}
+
+// EncodeFunction implements the blueprint_component_encode provider function.
+type EncodeFunction struct{}
+
+// NewEncodeFunction returns a new instance of EncodeFunction.
+func NewEncodeFunction() function.Function {
+	return &EncodeFunction{}
+}
+
+// Metadata sets the function name for the Terraform provider.
+func (f *EncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "blueprint_component_encode"
+}
+
+// Definition returns the parameters and return type of the function.
+func (f *EncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders typed blueprint component settings to the raw Jamf configuration JSON.",
+		Description: "Takes an object with the same attributes as the typed component block on jamfplatform_blueprints_blueprint and returns the configuration JSON that the blueprint sends to Jamf, including the Included markers for unset settings. " +
+			"The result can be used as configuration_json of a raw_component. Omitted attributes are treated as unset. Attribute validators of the block are not applied.",
+		Parameters: []function.Parameter{
+			componentParameter,
+			function.DynamicParameter{
+				Name:        "settings",
+				Description: "Object with the attributes of the typed component block.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the component configuration.
+func (f *EncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var settings types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &settings))
+	if resp.Error != nil {
+		return
+	}
+
+	registry, err := lookupComponent(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if settings.IsNull() || settings.IsUnderlyingValueNull() {
+		resp.Error = function.NewArgumentFuncError(1, "settings must not be null")
+		return
+	}
+
+	component, err := componentFromSettings(ctx, registry, settings.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	config, err := component.ToRawConfiguration()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("could not render %s configuration: %s", registry.Name(), err))
+		return
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(config); err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("could not encode %s configuration: %s", registry.Name(), err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.TrimSuffix(buf.String(), "\n")))
+}
+
+// DecodeFunction implements the blueprint_component_decode provider function.
+type DecodeFunction struct{}
+
+// NewDecodeFunction returns a new instance of DecodeFunction.
+func NewDecodeFunction() function.Function {
+	return &DecodeFunction{}
+}
+
+// Metadata sets the function name for the Terraform provider.
+func (f *DecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "blueprint_component_decode"
+}
+
+// Definition returns the parameters and return type of the function.
+func (f *DecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses raw Jamf component configuration JSON into typed component settings.",
+		Description: "Takes the configuration JSON of a component, as returned by the configuration_json attribute of the jamfplatform_blueprints_blueprint data source, and returns an object with the attributes of the typed component block. " +
+			"Settings that are not included in the configuration are null.",
+		Parameters: []function.Parameter{
+			componentParameter,
+			function.StringParameter{
+				Name:        "configuration",
+				Description: "Configuration JSON object of the component.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run parses the component configuration.
+func (f *DecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, configuration string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &configuration))
+	if resp.Error != nil {
+		return
+	}
+
+	registry, err := lookupComponent(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(configuration), &config); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "configuration must be a JSON object: "+err.Error())
+		return
+	}
+
+	component := registry.NewComponent()
+	if err := component.FromRawConfiguration(config); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("could not parse %s configuration: %s", registry.Name(), err))
+		return
+	}
+
+	value, diags := types.ObjectValueFrom(ctx, componentObjectType(registry).AttrTypes, component)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(value)))
+}
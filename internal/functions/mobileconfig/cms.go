@@ -0,0 +1,89 @@
+// Copyright 2025 Jamf Software LLC.
+
+package mobileconfig
+
+import (
+	"encoding/asn1"
+	"fmt"
+)
+
+// oidSignedData identifies CMS SignedData content (RFC 5652).
+var oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+// contentInfo is the outer CMS structure of a signed profile.
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+// signedData is the CMS SignedData structure. Only the encapsulated content is used.
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+// encapsulatedContentInfo holds the signed payload, which for profiles is the plist.
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+// unwrapSignedProfile returns the plist embedded in a DER-encoded CMS signed
+// profile. The signature is not verified.
+func unwrapSignedProfile(der []byte) ([]byte, error) {
+	var info contentInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid CMS signed profile: %w", err)
+	}
+	if !info.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("unsupported CMS content type %s, expected signed data", info.ContentType)
+	}
+
+	var sd signedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("invalid CMS signed data: %w", err)
+	}
+	if len(sd.EncapContentInfo.EContent.FullBytes) == 0 {
+		return nil, fmt.Errorf("CMS signed profile is detached and does not contain the profile")
+	}
+
+	// For explicitly tagged raw values, Bytes holds the complete inner element.
+	var eContent asn1.RawValue
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent.Bytes, &eContent); err != nil {
+		return nil, fmt.Errorf("invalid CMS signed content: %w", err)
+	}
+
+	return octetStringContent(eContent)
+}
+
+// octetStringContent returns the bytes of an OCTET STRING, joining the
+// segments of a constructed encoding.
+func octetStringContent(value asn1.RawValue) ([]byte, error) {
+	if value.Tag != asn1.TagOctetString {
+		return nil, fmt.Errorf("unexpected CMS content tag %d, expected octet string", value.Tag)
+	}
+	if !value.IsCompound {
+		return value.Bytes, nil
+	}
+
+	var content []byte
+	rest := value.Bytes
+	for len(rest) > 0 {
+		var segment asn1.RawValue
+		var err error
+		rest, err = asn1.Unmarshal(rest, &segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CMS content segment: %w", err)
+		}
+		segmentContent, err := octetStringContent(segment)
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, segmentContent...)
+	}
+	return content, nil
+}
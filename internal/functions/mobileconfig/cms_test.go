@@ -0,0 +1,193 @@
+// Copyright 2025 Jamf Software LLC.
+
+package mobileconfig
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+var oidData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+
+// testProfile is a profile large enough to need a long-form DER length once signed.
+var testProfile = []byte(plistDocument(`<dict><key>PayloadContent</key><array><dict><key>PayloadType</key><string>com.apple.wifi.managed</string><key>SSID_STR</key><string>Office</string></dict></array></dict>`))
+
+// mustMarshal DER-encodes value, failing the test on error.
+func mustMarshal(t *testing.T, value interface{}) []byte {
+	t.Helper()
+	der, err := asn1.Marshal(value)
+	if err != nil {
+		t.Fatalf("could not marshal test fixture: %v", err)
+	}
+	return der
+}
+
+// explicitTag wraps an encoded element in an explicit context-specific tag 0.
+func explicitTag(inner []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: inner}
+}
+
+// signedProfile builds an unsigned CMS structure of the given content type
+// around eContent, the encoded encapsulated content. A nil eContent produces a
+// detached signature.
+func signedProfile(t *testing.T, contentType asn1.ObjectIdentifier, eContent []byte) []byte {
+	t.Helper()
+
+	encap := struct {
+		EContentType asn1.ObjectIdentifier
+		EContent     asn1.RawValue `asn1:"optional"`
+	}{EContentType: oidData}
+	if eContent != nil {
+		encap.EContent = explicitTag(eContent)
+	}
+
+	emptySet := asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true}
+	sd := mustMarshal(t, struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		EncapContentInfo interface{}
+		SignerInfos      asn1.RawValue
+	}{1, emptySet, encap, emptySet})
+
+	return mustMarshal(t, struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}{contentType, explicitTag(sd)})
+}
+
+func TestUnwrapSignedProfile(t *testing.T) {
+	// A BER constructed OCTET STRING splits the content into segments.
+	half := len(testProfile) / 2
+	constructed := mustMarshal(t, asn1.RawValue{
+		Class:      asn1.ClassUniversal,
+		Tag:        asn1.TagOctetString,
+		IsCompound: true,
+		Bytes:      append(mustMarshal(t, testProfile[:half]), mustMarshal(t, testProfile[half:])...),
+	})
+
+	tests := []struct {
+		name      string
+		der       []byte
+		want      []byte
+		wantError string
+	}{
+		{
+			name: "signed profile",
+			der:  signedProfile(t, oidSignedData, mustMarshal(t, testProfile)),
+			want: testProfile,
+		},
+		{
+			name: "constructed octet string",
+			der:  signedProfile(t, oidSignedData, constructed),
+			want: testProfile,
+		},
+		{
+			name:      "not signed data",
+			der:       signedProfile(t, oidData, mustMarshal(t, testProfile)),
+			wantError: "unsupported CMS content type 1.2.840.113549.1.7.1, expected signed data",
+		},
+		{
+			name:      "detached signature",
+			der:       signedProfile(t, oidSignedData, nil),
+			wantError: "CMS signed profile is detached and does not contain the profile",
+		},
+		{
+			name:      "content is not an octet string",
+			der:       signedProfile(t, oidSignedData, mustMarshal(t, 42)),
+			wantError: "unexpected CMS content tag 2, expected octet string",
+		},
+		{
+			name:      "not DER",
+			der:       []byte("<plist/>"),
+			wantError: "invalid CMS signed profile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := unwrapSignedProfile(tt.der)
+
+			if tt.wantError != "" {
+				if err == nil {
+					t.Fatalf("unwrapSignedProfile() = %q, want error %q", got, tt.wantError)
+				}
+				if !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("error = %q, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("unwrapSignedProfile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayloadsJSON(t *testing.T) {
+	signed := signedProfile(t, oidSignedData, mustMarshal(t, testProfile))
+	want := `[{"SSID_STR":"Office","payloadType":"com.apple.wifi.managed"}]`
+
+	tests := []struct {
+		name      string
+		profile   []byte
+		want      string
+		wantError string
+	}{
+		{name: "unsigned profile", profile: testProfile, want: want},
+		{name: "signed profile", profile: signed, want: want},
+		{name: "base64 signed profile", profile: []byte(base64.StdEncoding.EncodeToString(signed)), want: want},
+		{
+			name:      "plist without payloads",
+			profile:   []byte(plistDocument(`<dict><key>PayloadType</key><string>Configuration</string></dict>`)),
+			wantError: "profile has no PayloadContent array",
+		},
+		{
+			name:      "plist root is not a dict",
+			profile:   []byte(plistDocument(`<array/>`)),
+			wantError: "profile root must be a dict",
+		},
+		{
+			name:      "signed content is not a plist",
+			profile:   signedProfile(t, oidSignedData, mustMarshal(t, bytes.Repeat([]byte("x"), 200))),
+			wantError: "profile does not contain an XML property list",
+		},
+		{
+			name:      "binary plist",
+			profile:   []byte(base64.StdEncoding.EncodeToString([]byte("bplist00"))),
+			wantError: "binary property lists are not supported",
+		},
+		{
+			name:      "neither XML nor base64",
+			profile:   []byte("not a profile!"),
+			wantError: "profile is neither an XML plist nor base64 encoded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := payloadsJSON(tt.profile)
+
+			if tt.wantError != "" {
+				if err == nil {
+					t.Fatalf("payloadsJSON() = %s, want error %q", got, tt.wantError)
+				}
+				if !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("error = %q, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("payloadsJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
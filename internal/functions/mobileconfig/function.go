@@ -0,0 +1,164 @@
+// Copyright 2025 Jamf Software LLC.
+
+package mobileconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PayloadsFunction{}
+
+// PayloadsFunction implements the mobileconfig_payloads provider function.
+type PayloadsFunction struct{}
+
+// NewPayloadsFunction returns a new instance of PayloadsFunction.
+func NewPayloadsFunction() function.Function {
+	return &PayloadsFunction{}
+}
+
+// Metadata sets the function name for the Terraform provider.
+func (f *PayloadsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mobileconfig_payloads"
+}
+
+// Definition returns the parameters and return type of the function.
+func (f *PayloadsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a .mobileconfig profile into legacy_payloads JSON.",
+		Description: "Parses an XML property list configuration profile and returns the payloads in its PayloadContent as a normalized JSON array suitable for the legacy_payloads attribute of jamfplatform_blueprints_blueprint. " +
+			"Unsigned profiles can be passed with file(); signed profiles are binary, so pass them base64 encoded with filebase64(). The CMS signature is removed but not verified. " +
+			"Top-level Payload* keys of each payload are renamed to start with a lowercase letter (for example PayloadType becomes payloadType). Integers and reals are kept exact, dates become RFC 3339 strings in UTC and data becomes base64 strings.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "profile",
+				Description: "Contents of the .mobileconfig file, either as XML or base64 encoded.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run converts the profile into legacy payloads JSON.
+func (f *PayloadsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var profile string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &profile))
+	if resp.Error != nil {
+		return
+	}
+
+	payloads, err := payloadsJSON([]byte(profile))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, payloads))
+}
+
+// payloadsJSON extracts the payloads of a signed or unsigned profile and
+// returns them as a JSON array with sorted keys.
+func payloadsJSON(profile []byte) (string, error) {
+	plistData, err := profilePlist(profile)
+	if err != nil {
+		return "", err
+	}
+
+	root, err := parsePlist(plistData)
+	if err != nil {
+		return "", err
+	}
+
+	rootDict, ok := root.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("profile root must be a dict")
+	}
+
+	content, ok := rootDict["PayloadContent"].([]interface{})
+	if !ok {
+		return "", fmt.Errorf("profile has no PayloadContent array")
+	}
+
+	payloads := make([]interface{}, len(content))
+	for i, item := range content {
+		payload, ok := item.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("PayloadContent[%d] must be a dict", i)
+		}
+		payloads[i] = lowerPayloadKeys(payload)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(payloads); err != nil {
+		return "", fmt.Errorf("could not encode payloads: %w", err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// profilePlist returns the XML plist of a profile, decoding base64 input and
+// unwrapping CMS signed profiles.
+func profilePlist(profile []byte) ([]byte, error) {
+	data := trimLeading(profile)
+
+	if !bytes.HasPrefix(data, []byte("<")) && !isDER(data) {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(data)), ""))
+		if err != nil {
+			return nil, fmt.Errorf("profile is neither an XML plist nor base64 encoded: %w", err)
+		}
+		data = trimLeading(decoded)
+	}
+
+	if isDER(data) {
+		unwrapped, err := unwrapSignedProfile(data)
+		if err != nil {
+			return nil, err
+		}
+		data = trimLeading(unwrapped)
+	}
+
+	if bytes.HasPrefix(data, []byte("bplist")) {
+		return nil, fmt.Errorf("binary property lists are not supported; convert the profile to XML first")
+	}
+	if !bytes.HasPrefix(data, []byte("<")) {
+		return nil, fmt.Errorf("profile does not contain an XML property list")
+	}
+
+	return data, nil
+}
+
+// trimLeading removes leading whitespace and a UTF-8 byte order mark.
+func trimLeading(data []byte) []byte {
+	data = bytes.TrimLeftFunc(data, unicode.IsSpace)
+	return bytes.TrimLeftFunc(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")), unicode.IsSpace)
+}
+
+// isDER reports whether data starts like a DER-encoded SEQUENCE with a
+// long-form length, as every signed profile does. Base64 text never matches.
+func isDER(data []byte) bool {
+	return len(data) > 1 && data[0] == 0x30 && data[1]&0x80 != 0
+}
+
+// lowerPayloadKeys renames the top-level Payload* keys of a payload to start
+// with a lowercase letter. Other keys and nested values are kept as-is.
+func lowerPayloadKeys(payload map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(payload))
+	for key, value := range payload {
+		if strings.HasPrefix(key, "Payload") {
+			key = "p" + key[1:]
+		}
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 Jamf Software LLC.
+
+package mobileconfig
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parsePlist decodes an XML property list into JSON-compatible Go values.
+// Integers and reals are returned as json.Number so they are encoded exactly,
+// dates as RFC 3339 strings in UTC and data as standard base64 strings.
+func parsePlist(data []byte) (interface{}, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = true
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no plist element found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist XML: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "plist" {
+			return nil, fmt.Errorf("expected plist root element, got <%s>", start.Name.Local)
+		}
+
+		value, end, err := nextValue(dec)
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			return nil, fmt.Errorf("plist element is empty")
+		}
+		return value, nil
+	}
+}
+
+// nextValue reads the next value element. end is true when the enclosing
+// element closes before another value starts.
+func nextValue(dec *xml.Decoder) (value interface{}, end bool, err error) {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid plist XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			value, err := parseValue(dec, t)
+			return value, false, err
+		case xml.EndElement:
+			return nil, true, nil
+		}
+	}
+}
+
+// parseValue decodes the value element that starts with start.
+func parseValue(dec *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "dict":
+		return parseDict(dec)
+	case "array":
+		return parseArray(dec)
+	case "true", "false":
+		if err := dec.Skip(); err != nil {
+			return nil, fmt.Errorf("invalid plist XML: %w", err)
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := dec.DecodeElement(&text, &start); err != nil {
+		return nil, fmt.Errorf("invalid plist <%s>: %w", start.Name.Local, err)
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		return parseInteger(strings.TrimSpace(text))
+	case "real":
+		return parseReal(strings.TrimSpace(text))
+	case "date":
+		date, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist <date> %q: %w", text, err)
+		}
+		return date.UTC().Format(time.RFC3339), nil
+	case "data":
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist <data>: %w", err)
+		}
+		return base64.StdEncoding.EncodeToString(decoded), nil
+	default:
+		return nil, fmt.Errorf("unsupported plist element <%s>", start.Name.Local)
+	}
+}
+
+// parseDict decodes the key/value pairs of a dict element.
+func parseDict(dec *xml.Decoder) (map[string]interface{}, error) {
+	dict := make(map[string]interface{})
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("invalid plist XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return dict, nil
+		case xml.StartElement:
+			if t.Name.Local != "key" {
+				return nil, fmt.Errorf("expected <key> in plist dict, got <%s>", t.Name.Local)
+			}
+			var key string
+			if err := dec.DecodeElement(&key, &t); err != nil {
+				return nil, fmt.Errorf("invalid plist <key>: %w", err)
+			}
+
+			value, end, err := nextValue(dec)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			if end {
+				return nil, fmt.Errorf("plist dict key %q has no value", key)
+			}
+			dict[key] = value
+		}
+	}
+}
+
+// parseArray decodes the values of an array element.
+func parseArray(dec *xml.Decoder) ([]interface{}, error) {
+	array := []interface{}{}
+	for {
+		value, end, err := nextValue(dec)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", len(array), err)
+		}
+		if end {
+			return array, nil
+		}
+		array = append(array, value)
+	}
+}
+
+// parseInteger validates a plist integer, including hexadecimal and values
+// beyond 64 bits, and returns it as an exact JSON number.
+func parseInteger(text string) (json.Number, error) {
+	digits, base := text, 10
+	if trimmed, found := strings.CutPrefix(strings.ToLower(text), "0x"); found {
+		digits, base = trimmed, 16
+	} else if trimmed, found := strings.CutPrefix(strings.ToLower(text), "-0x"); found {
+		digits, base = "-"+trimmed, 16
+	}
+
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return "", fmt.Errorf("invalid plist <integer> %q", text)
+	}
+	return json.Number(n.String()), nil
+}
+
+// parseReal validates a plist real and returns it as a JSON number.
+func parseReal(text string) (json.Number, error) {
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid plist <real> %q: %w", text, err)
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("plist <real> %q cannot be represented in JSON", text)
+	}
+	return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+}
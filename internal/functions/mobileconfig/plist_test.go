@@ -0,0 +1,175 @@
+// Copyright 2025 Jamf Software LLC.
+
+package mobileconfig
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// plistDocument wraps body in a plist document with the usual prolog.
+func plistDocument(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">` + body + `</plist>`
+}
+
+func TestParsePlist(t *testing.T) {
+	tests := []struct {
+		name      string
+		plist     string
+		want      interface{}
+		wantError string
+	}{
+		{
+			name: "nested dict and array",
+			plist: plistDocument(`
+				<dict>
+					<key>PayloadContent</key>
+					<array>
+						<dict>
+							<key>PayloadType</key>
+							<string>com.apple.wifi.managed</string>
+							<key>Nested</key>
+							<dict>
+								<key>List</key>
+								<array><string>a</string><array/></array>
+							</dict>
+						</dict>
+					</array>
+					<key>Empty</key>
+					<dict/>
+				</dict>`),
+			want: map[string]interface{}{
+				"PayloadContent": []interface{}{
+					map[string]interface{}{
+						"PayloadType": "com.apple.wifi.managed",
+						"Nested": map[string]interface{}{
+							"List": []interface{}{"a", []interface{}{}},
+						},
+					},
+				},
+				"Empty": map[string]interface{}{},
+			},
+		},
+		{
+			name: "scalar values",
+			plist: plistDocument(`
+				<array>
+					<integer>42</integer>
+					<integer>-7</integer>
+					<integer>0x1F</integer>
+					<integer>18446744073709551617</integer>
+					<real>1.50</real>
+					<real>-2e3</real>
+					<true/>
+					<false/>
+					<date>2025-03-01T12:00:00+01:00</date>
+					<data>
+						aGVs
+						bG8=
+					</data>
+					<string> keeps spaces </string>
+					<string/>
+				</array>`),
+			want: []interface{}{
+				json.Number("42"),
+				json.Number("-7"),
+				json.Number("31"),
+				json.Number("18446744073709551617"),
+				json.Number("1.5"),
+				json.Number("-2000"),
+				true,
+				false,
+				"2025-03-01T11:00:00Z",
+				"aGVsbG8=",
+				" keeps spaces ",
+				"",
+			},
+		},
+		{
+			name:      "not well-formed",
+			plist:     plistDocument(`<dict><key>a</key><string>b</dict>`),
+			wantError: "element <string> closed by </dict>",
+		},
+		{
+			name:      "truncated",
+			plist:     `<plist version="1.0"><dict><key>a</key>`,
+			wantError: "invalid plist XML",
+		},
+		{
+			name:      "wrong root element",
+			plist:     `<dict><key>a</key><string>b</string></dict>`,
+			wantError: "expected plist root element, got <dict>",
+		},
+		{
+			name:      "empty plist",
+			plist:     plistDocument(``),
+			wantError: "plist element is empty",
+		},
+		{
+			name:      "no plist element",
+			plist:     `<?xml version="1.0" encoding="UTF-8"?>`,
+			wantError: "no plist element found",
+		},
+		{
+			name:      "value without key",
+			plist:     plistDocument(`<dict><string>b</string></dict>`),
+			wantError: "expected <key> in plist dict, got <string>",
+		},
+		{
+			name:      "key without value",
+			plist:     plistDocument(`<dict><key>a</key></dict>`),
+			wantError: `plist dict key "a" has no value`,
+		},
+		{
+			name:      "invalid integer",
+			plist:     plistDocument(`<dict><key>a</key><integer>1.5</integer></dict>`),
+			wantError: `a: invalid plist <integer> "1.5"`,
+		},
+		{
+			name:      "invalid real",
+			plist:     plistDocument(`<array><real>nan</real></array>`),
+			wantError: `[0]: plist <real> "nan" cannot be represented in JSON`,
+		},
+		{
+			name:      "invalid date",
+			plist:     plistDocument(`<date>yesterday</date>`),
+			wantError: `invalid plist <date> "yesterday"`,
+		},
+		{
+			name:      "invalid data",
+			plist:     plistDocument(`<data>not base64!</data>`),
+			wantError: "invalid plist <data>",
+		},
+		{
+			name:      "unsupported element",
+			plist:     plistDocument(`<uid>1</uid>`),
+			wantError: "unsupported plist element <uid>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePlist([]byte(tt.plist))
+
+			if tt.wantError != "" {
+				if err == nil {
+					t.Fatalf("parsePlist() = %#v, want error %q", got, tt.wantError)
+				}
+				if !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("error = %q, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePlist() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
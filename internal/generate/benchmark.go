@@ -0,0 +1,92 @@
+// Copyright 2025 Jamf Software LLC.
+
+package generate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+// benchmarkResourceType is the resource type generated for benchmarks.
+const benchmarkResourceType = "jamfplatform_cbengine_benchmark"
+
+// sourceBaselinePlaceholder is written when the source baseline of a benchmark cannot be determined.
+const sourceBaselinePlaceholder = "REPLACE_WITH_SOURCE_BASELINE_ID"
+
+// writeBenchmark adds the resource block for a benchmark to the body. The API
+// does not return the source baseline of a benchmark, so a placeholder is
+// written together with the baselines available in the tenant.
+func writeBenchmark(body *hclBody, label string, bench *client.CBEngineBenchmarkResponseV2, baselines []client.CBEngineBaselineInfoV1) {
+	resource := body.block("resource", benchmarkResourceType, label)
+	resource.attribute("title", hclString(bench.Title))
+	if bench.Description != "" {
+		resource.attribute("description", hclString(bench.Description))
+	}
+
+	resource.comment("The API does not return the source baseline. Set it to the baseline this benchmark was created from.")
+	if len(baselines) > 0 {
+		resource.comment("Available baselines: " + baselineSummary(baselines))
+	}
+	resource.attribute("source_baseline_id", hclString(sourceBaselinePlaceholder))
+
+	deviceGroups := append([]string(nil), bench.Target.DeviceGroups...)
+	sort.Strings(deviceGroups)
+	resource.attribute("target_device_groups", hclStringList(deviceGroups))
+	resource.attribute("enforcement_mode", hclString(bench.EnforcementMode))
+	resource.attribute("sources", benchmarkSources(bench.Sources))
+	resource.attribute("rules", benchmarkRules(bench.Rules))
+}
+
+// baselineSummary lists baseline IDs with their titles.
+func baselineSummary(baselines []client.CBEngineBaselineInfoV1) string {
+	summaries := make([]string, 0, len(baselines))
+	for _, baseline := range baselines {
+		id := baseline.BaselineID
+		if id == "" {
+			id = baseline.ID
+		}
+		if baseline.Title != "" {
+			summaries = append(summaries, fmt.Sprintf("%s (%s)", id, baseline.Title))
+		} else {
+			summaries = append(summaries, id)
+		}
+	}
+	sort.Strings(summaries)
+	return strings.Join(summaries, ", ")
+}
+
+// benchmarkSources renders the sources attribute.
+func benchmarkSources(sources []client.CBEngineSourceV1) string {
+	elems := make([]string, len(sources))
+	for i, source := range sources {
+		var body hclBody
+		body.attribute("branch", hclString(source.Branch))
+		body.attribute("revision", hclString(source.Revision))
+		elems[i] = hclObject(&body)
+	}
+	return hclMultilineList(elems)
+}
+
+// benchmarkRules renders the rules attribute keyed by rule ID, including the
+// organization-defined value of rules that have one.
+func benchmarkRules(rules []client.CBEngineRuleInfoV1) string {
+	sorted := append([]client.CBEngineRuleInfoV1(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	var body hclBody
+	for _, rule := range sorted {
+		var attrs hclBody
+		attrs.attribute("enabled", fmt.Sprint(rule.Enabled))
+		if rule.ODV != nil {
+			attrs.attribute("odv_value", hclString(rule.ODV.Value))
+		}
+		body.attribute(hclObjectKey(rule.ID), hclObject(&attrs))
+	}
+	if len(body.items) == 0 {
+		return "{}"
+	}
+	return hclObject(&body)
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 Jamf Software LLC.
+
+package generate
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint/components"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// blueprintResourceType is the resource type generated for blueprints.
+const blueprintResourceType = "jamfplatform_blueprints_blueprint"
+
+// writeBlueprint adds the resource block for a blueprint to the body. The
+// components are mapped the same way as on import, so that the generated
+// configuration matches the imported state.
+func writeBlueprint(ctx context.Context, body *hclBody, label string, bp *client.BlueprintDetailV1) error {
+	model, diags := blueprint.ModelFromAPI(bp)
+	if diags.HasError() {
+		return fmt.Errorf("could not read blueprint %s: %s", bp.ID, diags.Errors()[0].Detail())
+	}
+
+	resource := body.block("resource", blueprintResourceType, label)
+	resource.attribute("name", hclString(bp.Name))
+	if bp.Description != "" {
+		resource.attribute("description", hclString(bp.Description))
+	}
+	deviceGroups := append([]string(nil), bp.Scope.DeviceGroups...)
+	sort.Strings(deviceGroups)
+	resource.attribute("device_groups", hclStringList(deviceGroups))
+
+	if len(model.Steps) == 0 {
+		return writeStepComponents(ctx, resource, &model.StepComponentsModel)
+	}
+
+	for i := range model.Steps {
+		step := resource.block("step")
+		step.attribute("name", hclString(model.Steps[i].Name.ValueString()))
+		if err := writeStepComponents(ctx, step, &model.Steps[i].StepComponentsModel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeStepComponents adds the component blocks and legacy payloads of a step to the body.
+func writeStepComponents(ctx context.Context, body *hclBody, step *blueprint.StepComponentsModel) error {
+	if !step.LegacyPayloads.IsNull() {
+		expr, err := hclJSONEncode([]byte(step.LegacyPayloads.ValueString()))
+		if err != nil {
+			return fmt.Errorf("could not render legacy payloads: %w", err)
+		}
+		body.attribute("legacy_payloads", expr)
+	}
+
+	for _, component := range step.TypedComponents() {
+		registry, ok := components.LookupComponentRegistry(component.GetIdentifier())
+		if !ok {
+			return fmt.Errorf("no typed block registered for component %s", component.GetIdentifier())
+		}
+
+		blockSchema := registry.Schema()
+		value, diags := types.ObjectValueFrom(ctx, blockSchema.Type().(basetypes.ObjectType).AttrTypes, component)
+		if diags.HasError() {
+			return fmt.Errorf("could not render %s: %s", registry.BlockName(), diags.Errors()[0].Detail())
+		}
+		writeObject(body.block(registry.BlockName()), blockSchema.Attributes, blockSchema.Blocks, value)
+	}
+
+	for _, component := range step.Components {
+		raw := body.block("raw_component")
+		raw.attribute("identifier", hclString(component.Identifier.ValueString()))
+		if component.ConfigurationJSON.IsNull() {
+			continue
+		}
+		expr, err := hclJSONEncode([]byte(component.ConfigurationJSON.ValueString()))
+		if err != nil {
+			return fmt.Errorf("could not render configuration of component %s: %w", component.Identifier.ValueString(), err)
+		}
+		raw.attribute("configuration_json", expr)
+	}
+	return nil
+}
+
+// writeObject adds the non-null attributes and nested blocks of a typed
+// component value to the body, following the component block schema.
+func writeObject(body *hclBody, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value types.Object) {
+	values := value.Attributes()
+
+	for _, name := range sortedKeys(attributes) {
+		if expr, ok := hclAttrValue(values[name]); ok {
+			body.attribute(name, expr)
+		}
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		switch block := blocks[name].(type) {
+		case schema.ListNestedBlock:
+			list, ok := values[name].(types.List)
+			if !ok || list.IsNull() {
+				continue
+			}
+			for _, elem := range list.Elements() {
+				if obj, ok := elem.(types.Object); ok {
+					writeObject(body.block(name), block.NestedObject.Attributes, block.NestedObject.Blocks, obj)
+				}
+			}
+		case schema.SingleNestedBlock:
+			obj, ok := values[name].(types.Object)
+			if !ok || obj.IsNull() {
+				continue
+			}
+			writeObject(body.block(name), block.Attributes, block.Blocks, obj)
+		}
+	}
+}
+
+// hclAttrValue renders a primitive or list attribute value. It reports false for null values.
+func hclAttrValue(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return hclString(v.ValueString()), true
+	case types.Bool:
+		return fmt.Sprint(v.ValueBool()), true
+	case types.Int64:
+		return fmt.Sprint(v.ValueInt64()), true
+	case types.Float64:
+		return big.NewFloat(v.ValueFloat64()).Text('g', -1), true
+	case types.Number:
+		return v.ValueBigFloat().Text('g', -1), true
+	case types.List:
+		return hclElements(v.Elements()), true
+	case types.Set:
+		return hclElements(v.Elements()), true
+	default:
+		return hclString(value.String()), true
+	}
+}
+
+// hclElements renders a single-line list of primitive values.
+func hclElements(elements []attr.Value) string {
+	exprs := make([]string, len(elements))
+	for i, elem := range elements {
+		expr, ok := hclAttrValue(elem)
+		if !ok {
+			expr = "null"
+		}
+		exprs[i] = expr
+	}
+	return "[" + strings.Join(exprs, ", ") + "]"
+}
+
+// sortedKeys returns the keys of a map in lexical order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
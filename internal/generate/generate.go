@@ -0,0 +1,108 @@
+// Copyright 2025 Jamf Software LLC.
+
+// Package generate writes Terraform configuration and import blocks for the
+// blueprints and benchmarks that already exist in a Jamf tenant.
+package generate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+// Options selects the objects to generate configuration for.
+type Options struct {
+	Blueprints bool
+	Benchmarks bool
+}
+
+// Generate writes a resource block and a matching import block for every
+// selected blueprint and benchmark in the tenant to w.
+func Generate(ctx context.Context, c *client.Client, w io.Writer, opts Options) error {
+	var root hclBody
+
+	if opts.Blueprints {
+		if err := generateBlueprints(ctx, c, &root); err != nil {
+			return err
+		}
+	}
+
+	if opts.Benchmarks {
+		if err := generateBenchmarks(ctx, c, &root); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# Generated from the Jamf tenant. Review the configuration, then run terraform plan to import it.\n\n")
+	root.write(&buf, 0)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// generateBlueprints adds all blueprints, ordered by name, to the body.
+func generateBlueprints(ctx context.Context, c *client.Client, root *hclBody) error {
+	overviews, err := c.GetBlueprintsV1(ctx, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to list blueprints: %w", err)
+	}
+	sort.SliceStable(overviews, func(i, j int) bool { return overviews[i].Name < overviews[j].Name })
+
+	labels := make(map[string]bool)
+	for _, overview := range overviews {
+		bp, err := c.GetBlueprintByIDV1(ctx, overview.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get blueprint %s: %w", overview.ID, err)
+		}
+
+		label := resourceLabel(bp.Name, labels)
+		if err := writeBlueprint(ctx, root, label, bp); err != nil {
+			return err
+		}
+		writeImport(root, blueprintResourceType, label, bp.ID)
+	}
+	return nil
+}
+
+// generateBenchmarks adds all benchmarks, ordered by title, to the body.
+func generateBenchmarks(ctx context.Context, c *client.Client, root *hclBody) error {
+	list, err := c.GetCBEngineBenchmarksV2(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list benchmarks: %w", err)
+	}
+	overviews := list.Benchmarks
+	sort.SliceStable(overviews, func(i, j int) bool { return overviews[i].Title < overviews[j].Title })
+
+	var baselines []client.CBEngineBaselineInfoV1
+	if len(overviews) > 0 {
+		baselineList, err := c.GetCBEngineBaselinesV1(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list baselines: %w", err)
+		}
+		baselines = baselineList.Baselines
+	}
+
+	labels := make(map[string]bool)
+	for _, overview := range overviews {
+		bench, err := c.GetCBEngineBenchmarkByIDV2(ctx, overview.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get benchmark %s: %w", overview.ID, err)
+		}
+
+		label := resourceLabel(bench.Title, labels)
+		writeBenchmark(root, label, bench, baselines)
+		writeImport(root, benchmarkResourceType, label, bench.BenchmarkID)
+	}
+	return nil
+}
+
+// writeImport adds an import block for a generated resource.
+func writeImport(root *hclBody, resourceType, label, id string) {
+	body := root.block("import")
+	body.attribute("to", resourceType+"."+label)
+	body.attribute("id", hclString(id))
+}
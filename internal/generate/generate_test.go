@@ -0,0 +1,145 @@
+// Copyright 2025 Jamf Software LLC.
+
+package generate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// assertGolden compares got with testdata/name, rewriting the file when -update is set.
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", name)
+
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatalf("could not update %s: %v", golden, err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("could not read %s: %v", golden, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s, run go test with -update to accept it\ngot:\n%s", golden, got)
+	}
+}
+
+// rawConfiguration marshals a component configuration fixture.
+func rawConfiguration(t *testing.T, value interface{}) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("could not marshal fixture: %v", err)
+	}
+	return data
+}
+
+func TestWriteGolden(t *testing.T) {
+	blueprints := []*client.BlueprintDetailV1{
+		{
+			ID:          "3c8e1d1a-7f6b-4b0e-9a34-1f2d5c6b7a89",
+			Name:        `Office "HQ" ${region}`,
+			Description: "Line 1\nLine 2 with 100%{done}",
+			Scope:       client.BlueprintUpdateScopeV1{DeviceGroups: []string{"group-b", "group-a"}},
+			Steps: []client.BlueprintStepV1{{
+				Name: "Step 1",
+				Components: []client.BlueprintComponentV1{
+					{
+						Identifier: "com.jamf.ddm.passcode-settings",
+						Configuration: rawConfiguration(t, map[string]interface{}{
+							"RequirePasscode": true,
+							"MinimumLength":   12,
+						}),
+					},
+					{
+						Identifier: "com.jamf.ddm-configuration-profile",
+						Configuration: rawConfiguration(t, map[string]interface{}{
+							"payloadContent": []interface{}{map[string]interface{}{
+								"payloadType":       "com.apple.dock",
+								"payloadIdentifier": "com.example.dock",
+								"orientation":       "left",
+								"static-apps":       []interface{}{map[string]interface{}{"label": "${app}"}},
+							}},
+						}),
+					},
+					{
+						Identifier: "com.example.custom",
+						Configuration: rawConfiguration(t, map[string]interface{}{
+							"Script":   "#!/bin/sh\necho \"%{user}\" > C:\\tmp",
+							"Z":        []interface{}{},
+							"A":        map[string]interface{}{"b": 1.5, "a": nil},
+							"with.dot": false,
+						}),
+					},
+				},
+			}},
+		},
+		{
+			ID:    "9b1f0c2e-5d7a-4e3b-8c6f-2a4d1e0b9c87",
+			Name:  "Office HQ",
+			Scope: client.BlueprintUpdateScopeV1{DeviceGroups: []string{}},
+			Steps: []client.BlueprintStepV1{
+				{Name: "First", Components: []client.BlueprintComponentV1{{Identifier: "com.example.empty"}}},
+				{Name: "Second"},
+			},
+		},
+	}
+
+	benchmarks := []*client.CBEngineBenchmarkResponseV2{
+		{
+			BenchmarkID:     "benchmark-1",
+			Title:           "CIS Level 1",
+			Description:     `Uses "quotes"`,
+			Sources:         []client.CBEngineSourceV1{{Branch: "sonoma", Revision: "v1.0"}},
+			Target:          client.CBEngineTargetV2{DeviceGroups: []string{"group-2", "group-1"}},
+			EnforcementMode: "MONITOR_AND_ENFORCE",
+			Rules: []client.CBEngineRuleInfoV1{
+				{ID: "pwpolicy_minimum_length_enforce", Enabled: true, ODV: &client.CBEngineOrganizationDefinedValueV1{Value: "${length}"}},
+				{ID: "os_firewall_enable", Enabled: false},
+			},
+		},
+		{
+			BenchmarkID:     "benchmark-2",
+			Title:           "Empty",
+			Target:          client.CBEngineTargetV2{DeviceGroups: []string{"group-1"}},
+			EnforcementMode: "MONITOR",
+		},
+	}
+
+	baselines := []client.CBEngineBaselineInfoV1{
+		{BaselineID: "cis_lvl1", Title: "CIS Level 1"},
+		{ID: "800-53r5_low"},
+	}
+
+	var root hclBody
+	labels := make(map[string]bool)
+	for _, bp := range blueprints {
+		label := resourceLabel(bp.Name, labels)
+		if err := writeBlueprint(context.Background(), &root, label, bp); err != nil {
+			t.Fatalf("writeBlueprint(%s) failed: %v", bp.Name, err)
+		}
+		writeImport(&root, blueprintResourceType, label, bp.ID)
+	}
+	labels = make(map[string]bool)
+	for _, bench := range benchmarks {
+		label := resourceLabel(bench.Title, labels)
+		writeBenchmark(&root, label, bench, baselines)
+		writeImport(&root, benchmarkResourceType, label, bench.BenchmarkID)
+	}
+
+	var buf bytes.Buffer
+	root.write(&buf, 0)
+	assertGolden(t, "generate.golden", buf.Bytes())
+}
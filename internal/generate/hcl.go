@@ -0,0 +1,276 @@
+// Copyright 2025 Jamf Software LLC.
+
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// hclBody is a block body of attributes and nested blocks, written in the
+// layout produced by terraform fmt.
+type hclBody struct {
+	items []hclItem
+}
+
+// hclItem is either an attribute or a nested block of a body.
+type hclItem struct {
+	comment string
+	name    string
+	expr    string
+	block   *hclBlock
+}
+
+// hclBlock is a nested block with its type, labels and body.
+type hclBlock struct {
+	typ    string
+	labels []string
+	body   *hclBody
+}
+
+// attribute adds an attribute with an already rendered expression.
+func (b *hclBody) attribute(name, expr string) {
+	b.items = append(b.items, hclItem{name: name, expr: expr})
+}
+
+// comment adds a comment line before the next item.
+func (b *hclBody) comment(text string) {
+	b.items = append(b.items, hclItem{comment: text})
+}
+
+// block adds a nested block and returns its body.
+func (b *hclBody) block(typ string, labels ...string) *hclBody {
+	block := &hclBlock{typ: typ, labels: labels, body: &hclBody{}}
+	b.items = append(b.items, hclItem{block: block})
+	return block.body
+}
+
+// write renders the body at the given indentation level. Consecutive
+// attributes have their equals signs aligned and blocks are separated by
+// blank lines.
+func (b *hclBody) write(buf *bytes.Buffer, indent int) {
+	prefix := strings.Repeat("  ", indent)
+	for i := 0; i < len(b.items); {
+		item := b.items[i]
+		if i > 0 && b.items[i-1].comment == "" && (item.block != nil || item.comment != "" || b.items[i-1].block != nil) {
+			buf.WriteString("\n")
+		}
+
+		switch {
+		case item.comment != "":
+			buf.WriteString(prefix + "# " + item.comment + "\n")
+			i++
+		case item.block != nil:
+			buf.WriteString(prefix + item.block.typ)
+			for _, label := range item.block.labels {
+				buf.WriteString(" " + hclString(label))
+			}
+			if len(item.block.body.items) == 0 {
+				buf.WriteString(" {}\n")
+			} else {
+				buf.WriteString(" {\n")
+				item.block.body.write(buf, indent+1)
+				buf.WriteString(prefix + "}\n")
+			}
+			i++
+		default:
+			end := attributeGroupEnd(b.items, i)
+			width := 0
+			for _, attr := range b.items[i:end] {
+				width = max(width, len(attr.name))
+			}
+			for _, attr := range b.items[i:end] {
+				fmt.Fprintf(buf, "%s%-*s = %s\n", prefix, width, attr.name, indentExpr(attr.expr, prefix))
+			}
+			i = end
+		}
+	}
+}
+
+// attributeGroupEnd returns the end of the run of attributes starting at
+// start whose equals signs are aligned. A multi-line expression ends the run.
+func attributeGroupEnd(items []hclItem, start int) int {
+	end := start
+	for end < len(items) && items[end].block == nil && items[end].comment == "" {
+		end++
+		if strings.Contains(items[end-1].expr, "\n") {
+			break
+		}
+	}
+	return end
+}
+
+// indentExpr indents the continuation lines of a multi-line expression.
+func indentExpr(expr, prefix string) string {
+	return strings.ReplaceAll(expr, "\n", "\n"+prefix)
+}
+
+// hclString renders a quoted HCL string, escaping template sequences.
+func hclString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case unicode.IsControl(r):
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// hclStringList renders a single-line list of strings.
+func hclStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = hclString(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// hclJSONEncode renders a JSON document as a jsonencode() call so it can be
+// reviewed and edited as HCL.
+func hclJSONEncode(document []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(document))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return "", err
+	}
+	return "jsonencode(" + hclValue(value) + ")", nil
+}
+
+// hclValue renders a decoded JSON value as an HCL expression.
+func hclValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case string:
+		return hclString(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "[]"
+		}
+		elems := make([]string, len(v))
+		multiline := false
+		for i, elem := range v {
+			elems[i] = hclValue(elem)
+			switch elem.(type) {
+			case map[string]interface{}, []interface{}:
+				multiline = true
+			}
+		}
+		if !multiline {
+			return "[" + strings.Join(elems, ", ") + "]"
+		}
+		return hclMultilineList(elems)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var body hclBody
+		for _, key := range keys {
+			body.attribute(hclObjectKey(key), hclValue(v[key]))
+		}
+		return hclObject(&body)
+	default:
+		return hclString(fmt.Sprint(v))
+	}
+}
+
+// hclMultilineList renders a list with one element per line.
+func hclMultilineList(elems []string) string {
+	if len(elems) == 0 {
+		return "[]"
+	}
+	var sb strings.Builder
+	sb.WriteString("[\n")
+	for _, elem := range elems {
+		sb.WriteString("  " + indentExpr(elem, "  ") + ",\n")
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// hclObject renders the attributes of a body as an object constructor expression.
+func hclObject(body *hclBody) string {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	body.write(&buf, 1)
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// identifierPattern matches keys that can be written without quotes.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// hclObjectKey renders an object key, quoting it when it is not a valid identifier.
+func hclObjectKey(key string) string {
+	if identifierPattern.MatchString(key) && key != "null" {
+		return key
+	}
+	return hclString(key)
+}
+
+// resourceLabel converts a display name into a unique resource label.
+func resourceLabel(name string, used map[string]bool) string {
+	var sb strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sb.WriteRune(r)
+			underscore = false
+		} else if !underscore && sb.Len() > 0 {
+			sb.WriteByte('_')
+			underscore = true
+		}
+	}
+
+	label := strings.TrimSuffix(sb.String(), "_")
+	switch {
+	case label == "":
+		label = "unnamed"
+	case unicode.IsDigit(rune(label[0])):
+		label = "r_" + label
+	}
+
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[unique] = true
+	return unique
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 Jamf Software LLC.
+
+package generate
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHCLString(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "plain", value: "Office Wi-Fi", want: `"Office Wi-Fi"`},
+		{name: "empty", value: "", want: `""`},
+		{name: "quotes and backslashes", value: `say "hi" C:\path`, want: `"say \"hi\" C:\\path"`},
+		{name: "newlines and tabs", value: "line 1\r\nline 2\tend", want: `"line 1\r\nline 2\tend"`},
+		{name: "interpolation", value: "${var.name}", want: `"$${var.name}"`},
+		{name: "template directive", value: "%{if true}x%{endif}", want: `"%%{if true}x%%{endif}"`},
+		{name: "dollar and percent without brace", value: "$5 or 10% off", want: `"$5 or 10% off"`},
+		{name: "escape sequence at end", value: "ends with $", want: `"ends with $"`},
+		{name: "control characters", value: "bell\a null\x00", want: `"bell\u0007 null\u0000"`},
+		{name: "unicode", value: "Café ☕", want: `"Café ☕"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hclString(tt.value); got != tt.want {
+				t.Errorf("hclString(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHCLObjectKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "PayloadType", want: "PayloadType"},
+		{key: "with-dash_and_underscore", want: "with-dash_and_underscore"},
+		{key: "com.apple.wifi", want: `"com.apple.wifi"`},
+		{key: "1st", want: `"1st"`},
+		{key: "with space", want: `"with space"`},
+		{key: "null", want: `"null"`},
+		{key: "", want: `""`},
+		{key: "${key}", want: `"$${key}"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := hclObjectKey(tt.key); got != tt.want {
+				t.Errorf("hclObjectKey(%q) = %s, want %s", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHCLJSONEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     string
+	}{
+		{
+			name:     "scalars",
+			document: `[1, 2.50, 1e100, true, false, null, "a"]`,
+			want:     `jsonencode([1, 2.50, 1e100, true, false, null, "a"])`,
+		},
+		{
+			name:     "empty values",
+			document: `{"list": [], "object": {}}`,
+			want: `jsonencode({
+  list   = []
+  object = {}
+})`,
+		},
+		{
+			name:     "keys sorted and quoted",
+			document: `{"z": 1, "PayloadType": "com.apple.dock", "a.b": 2, "1": 3}`,
+			want: `jsonencode({
+  "1"         = 3
+  PayloadType = "com.apple.dock"
+  "a.b"       = 2
+  z           = 1
+})`,
+		},
+		{
+			name:     "nested objects and lists",
+			document: `[{"name": "${HOME}", "items": [{"b": 1, "a": [1, 2]}], "text": "a\nb"}]`,
+			want: `jsonencode([
+  {
+    items = [
+      {
+        a = [1, 2]
+        b = 1
+      },
+    ]
+    name = "$${HOME}"
+    text = "a\nb"
+  },
+])`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := hclJSONEncode([]byte(tt.document))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("hclJSONEncode() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := hclJSONEncode([]byte(`{"a":`)); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestHCLBodyWrite(t *testing.T) {
+	var root hclBody
+	resource := root.block("resource", "jamfplatform_example", "a\"b")
+	resource.attribute("name", hclString("x"))
+	resource.attribute("description", hclString("y"))
+	resource.comment("A comment")
+	resource.attribute("value", `jsonencode({
+  a = 1
+})`)
+	resource.attribute("after", "true")
+	resource.attribute("longest_name", "1")
+	nested := resource.block("nested")
+	nested.block("empty")
+	resource.block("empty")
+	root.block("import").attribute("to", "jamfplatform_example.a")
+
+	want := `resource "jamfplatform_example" "a\"b" {
+  name        = "x"
+  description = "y"
+
+  # A comment
+  value = jsonencode({
+    a = 1
+  })
+  after        = true
+  longest_name = 1
+
+  nested {
+    empty {}
+  }
+
+  empty {}
+}
+
+import {
+  to = jamfplatform_example.a
+}
+`
+
+	var buf bytes.Buffer
+	root.write(&buf, 0)
+	if got := buf.String(); got != want {
+		t.Errorf("write() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	used := make(map[string]bool)
+
+	steps := []struct {
+		name string
+		want string
+	}{
+		{name: "Office Wi-Fi", want: "office_wi_fi"},
+		{name: "Office  Wi-Fi!", want: "office_wi_fi_2"},
+		{name: "  CIS Level 1 (macOS) ", want: "cis_level_1_macos"},
+		{name: "2024 Baseline", want: "r_2024_baseline"},
+		{name: "Café", want: "caf"},
+		{name: "☕", want: "unnamed"},
+		{name: "", want: "unnamed_2"},
+	}
+
+	for _, step := range steps {
+		if got := resourceLabel(step.name, used); got != step.want {
+			t.Errorf("resourceLabel(%q) = %s, want %s", step.name, got, step.want)
+		}
+	}
+}
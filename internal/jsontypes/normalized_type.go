@@ -0,0 +1,71 @@
+// Copyright 2025 Jamf Software LLC.
+
+// Package jsontypes provides a Terraform string type for JSON documents that
+// compares values semantically, so formatting, key order and number notation
+// differences returned by the API do not show up as diffs.
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = NormalizedType{}
+
+// NormalizedType is an attribute type for JSON documents compared by value.
+type NormalizedType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t NormalizedType) String() string {
+	return "jsontypes.NormalizedType"
+}
+
+// ValueType returns the Value type.
+func (t NormalizedType) ValueType(ctx context.Context) attr.Value {
+	return Normalized{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NormalizedType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NormalizedType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Normalized{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
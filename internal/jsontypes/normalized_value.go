@@ -0,0 +1,149 @@
+// Copyright 2025 Jamf Software LLC.
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+	_ xattr.ValidateableAttribute                = Normalized{}
+)
+
+// Normalized is a JSON document value. Two values are semantically equal when
+// they decode to the same JSON value, regardless of whitespace, key order or
+// number notation.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedNull creates a Normalized with a null value.
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+// NewNormalizedUnknown creates a Normalized with an unknown value.
+func NewNormalizedUnknown() Normalized {
+	return Normalized{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewNormalizedValue creates a Normalized with a known value.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns a NormalizedType.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values decode to the same JSON value.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	oldNormalized, err := normalize(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	newNormalized, err := normalize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldNormalized == newNormalized, diags
+}
+
+// ValidateAttribute reports an error when the value is not valid JSON.
+func (v Normalized) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.\n\n"+
+				"Given Value: "+v.ValueString(),
+		)
+	}
+}
+
+// Unmarshal decodes the JSON value into target.
+func (v Normalized) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.AddError("Normalized JSON Unmarshal Error", "JSON string value is null or unknown")
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), target); err != nil {
+		diags.AddError("Normalized JSON Unmarshal Error", err.Error())
+	}
+
+	return diags
+}
+
+// Normalize returns the JSON document with sorted keys and no insignificant
+// whitespace. Invalid JSON is returned unchanged.
+func Normalize(jsonStr string) string {
+	if jsonStr == "" {
+		return ""
+	}
+
+	normalized, err := normalize(jsonStr)
+	if err != nil {
+		return jsonStr
+	}
+
+	return normalized
+}
+
+// normalize decodes and re-encodes the JSON document so equal values produce equal strings.
+func normalize(jsonStr string) (string, error) {
+	var obj interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &obj); err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Jamf Software LLC.
+
+// Package poll provides the poll_interval handling shared by resources that
+// wait for the API to finish a long-running operation.
+package poll
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultInterval is used when poll_interval is not configured.
+const DefaultInterval = 5 * time.Second
+
+// Interval returns the configured poll interval or the default of 5 seconds.
+func Interval(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return DefaultInterval, nil
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid poll_interval %q: %w", value.ValueString(), err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("poll_interval must be positive, got %q", value.ValueString())
+	}
+	return d, nil
+}
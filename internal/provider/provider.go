@@ -5,20 +5,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/functions/blueprintcomponent"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/functions/mobileconfig"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprints"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/component"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/components"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/deployment"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/cbengine/baselines"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/cbengine/benchmark"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/cbengine/benchmarks"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/cbengine/rules"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/inventory/computer"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/inventory/computers"
@@ -35,6 +46,7 @@ const (
 
 // Ensure JamfPlatformProvider satisfies the provider.Provider interface.
 var _ provider.Provider = &JamfPlatformProvider{}
+var _ provider.ProviderWithFunctions = &JamfPlatformProvider{}
 
 // JamfPlatformProvider implements the Terraform provider for Jamf Platform.
 type JamfPlatformProvider struct {
@@ -43,9 +55,12 @@ type JamfPlatformProvider struct {
 
 // JamfPlatformProviderModel describes the provider data model for configuration.
 type JamfPlatformProviderModel struct {
-	BaseURL      types.String `tfsdk:"base_url"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	BaseURL           types.String  `tfsdk:"base_url"`
+	ClientID          types.String  `tfsdk:"client_id"`
+	ClientSecret      types.String  `tfsdk:"client_secret"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
 }
 
 // Metadata sets the provider type name for the Terraform provider.
@@ -73,6 +88,21 @@ func (p *JamfPlatformProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Sensitive:   true,
 				Description: "OAuth client secret for Jamf Platform API. Can also be set via the JAMFPLATFORM_CLIENT_SECRET environment variable.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of times a request is retried after a transient failure (HTTP 429, 502, 503, 504 or a dropped connection). Only idempotent requests are retried, except for 429 responses. Defaults to 3; set to 0 to disable retries.",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of seconds to wait between retries, including waits requested by a Retry-After header. Also caps how long all requests pause when the API reports that the rate limit is exhausted. Backoff starts at 1 second and doubles on each retry. Defaults to 30.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum average number of API requests per second, shared across all resources and data sources in a run. Short bursts up to the same number of requests are allowed. Regardless of this setting, the provider pauses all requests when the API reports an exhausted rate limit. Defaults to no client-side limit.",
+				Validators:  []validator.Float64{float64validator.AtLeast(0)},
+			},
 		},
 	}
 }
@@ -125,6 +155,20 @@ func (p *JamfPlatformProvider) Configure(ctx context.Context, req provider.Confi
 
 	apiClient := client.NewClient(baseURL, clientID, clientSecret)
 
+	retryPolicy := client.DefaultRetryPolicy()
+	if !data.MaxRetries.IsNull() {
+		retryPolicy.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMaxWait.IsNull() {
+		retryPolicy.MaxBackoff = time.Duration(data.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	apiClient.SetRetryPolicy(retryPolicy)
+
+	if !data.RequestsPerSecond.IsNull() {
+		rps := data.RequestsPerSecond.ValueFloat64()
+		apiClient.SetRateLimit(rps, int(math.Ceil(rps)))
+	}
+
 	apiClient.SetLogger(NewTerraformLogger())
 
 	if _, err := apiClient.OAuthClient().GetValidToken(ctx); err != nil {
@@ -144,17 +188,28 @@ func (p *JamfPlatformProvider) Resources(_ context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		benchmark.NewBenchmarkResource,
 		blueprint.NewBlueprintResource,
+		deployment.NewDeploymentResource,
+	}
+}
+
+func (p *JamfPlatformProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		mobileconfig.NewPayloadsFunction,
+		blueprintcomponent.NewEncodeFunction,
+		blueprintcomponent.NewDecodeFunction,
 	}
 }
 
 func (p *JamfPlatformProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		blueprint.NewBlueprintDataSource,
+		blueprints.NewBlueprintsDataSource,
 		component.NewComponentDataSource,
 		components.NewComponentsDataSource,
 		baselines.NewBaselinesDataSource,
 		rules.NewRulesDataSource,
 		benchmark.NewBenchmarkDataSource,
+		benchmarks.NewBenchmarksDataSource,
 		mobiledevices.NewDataSourceMobileDevices,
 		computers.NewDataSourceComputers,
 		computer.NewDataSourceComputer,
@@ -4,6 +4,8 @@ package components
 
 import (
 	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 // BlueprintComponentData represents the generic structure for any blueprint component
@@ -22,26 +24,64 @@ type ComponentConverter interface {
 	ToClientComponent() (*BlueprintComponentData, error)
 }
 
-// ComponentRegistry maps component identifiers to their human-readable names for easier management
+// ComponentRegistry maps component identifiers to their human-readable names, resource block names and
+// typed implementations for easier management
 type ComponentRegistry struct {
-	identifier string
-	name       string
+	identifier   string
+	name         string
+	blockName    string
+	schema       func() schema.NestedBlockObject
+	newComponent func() ComponentConverter
+}
+
+// Identifier returns the component identifier used by the API.
+func (r ComponentRegistry) Identifier() string {
+	return r.identifier
+}
+
+// Name returns the human-readable component name.
+func (r ComponentRegistry) Name() string {
+	return r.name
+}
+
+// BlockName returns the name of the component block on the blueprint resource.
+func (r ComponentRegistry) BlockName() string {
+	return r.blockName
+}
+
+// Schema returns the Terraform schema of the typed component.
+func (r ComponentRegistry) Schema() schema.NestedBlockObject {
+	return r.schema()
+}
+
+// NewComponent returns an empty typed component.
+func (r ComponentRegistry) NewComponent() ComponentConverter {
+	return r.newComponent()
 }
 
 // CommonComponentRegistries defines all supported strongly-typed components
 var CommonComponentRegistries = []ComponentRegistry{
-	{"com.jamf.ddm.audio-accessory-settings", "Audio Accessory Settings"},
-	{"com.jamf.ddm.disk-management", "Disk Management Settings"},
-	{"com.jamf.ddm.math-settings", "Math Settings"},
-	{"com.jamf.ddm.passcode", "Passcode Policy"},
-	{"com.jamf.ddm.safari-bookmarks", "Safari Bookmarks"},
-	{"com.jamf.ddm.safari-extensions", "Safari Extensions"},
-	{"com.jamf.ddm.safari-settings", "Safari Settings"},
-	{"com.jamf.ddm.service-background-tasks", "Service Background Tasks"},
-	{"com.jamf.ddm.service-configuration-files", "Service Configuration Files"},
-	{"com.jamf.ddm.sw-updates", "Software Update"},
-	{"com.jamf.ddm.software-update-settings", "Software Update Settings"},
-	{"com.jamf.ddm-configuration-profile", "Legacy Payloads"},
-	// Future components can be added here by following the template in README.md:
-	// {"com.jamf.ddm.passcode", "Passcode Settings"},
+	{"com.jamf.ddm.audio-accessory-settings", "Audio Accessory Settings", "audio_accessory_settings", AudioAccessorySettingsComponentSchema, func() ComponentConverter { return &AudioAccessorySettingsComponent{} }},
+	{"com.jamf.ddm.disk-management", "Disk Management Settings", "disk_management_settings", DiskManagementPolicyComponentSchema, func() ComponentConverter { return &DiskManagementPolicyComponent{} }},
+	{"com.jamf.ddm.math-settings", "Math Settings", "math_settings", MathSettingsComponentSchema, func() ComponentConverter { return &MathSettingsComponent{} }},
+	{"com.jamf.ddm.passcode-settings", "Passcode Policy", "passcode_policy", PasscodePolicyComponentSchema, func() ComponentConverter { return &PasscodePolicyComponent{} }},
+	{"com.jamf.ddm.safari-bookmarks", "Safari Bookmarks", "safari_bookmarks", SafariBookmarksComponentSchema, func() ComponentConverter { return &SafariBookmarksComponent{} }},
+	{"com.jamf.ddm.safari-extensions", "Safari Extensions", "safari_extensions", SafariExtensionsComponentSchema, func() ComponentConverter { return &SafariExtensionsComponent{} }},
+	{"com.jamf.ddm.safari-settings", "Safari Settings", "safari_settings", SafariSettingsComponentSchema, func() ComponentConverter { return &SafariSettingsComponent{} }},
+	{"com.jamf.ddm.service-background-tasks", "Service Background Tasks", "service_background_tasks", ServiceBackgroundTasksComponentSchema, func() ComponentConverter { return &ServiceBackgroundTasksComponent{} }},
+	{"com.jamf.ddm.service-configuration-files", "Service Configuration Files", "service_configuration_files", ServiceConfigurationFilesComponentSchema, func() ComponentConverter { return &ServiceConfigurationFilesComponent{} }},
+	{"com.jamf.ddm.sw-updates", "Software Update", "software_update", SoftwareUpdateComponentSchema, func() ComponentConverter { return &SoftwareUpdateComponent{} }},
+	{"com.jamf.ddm.software-update-settings", "Software Update Settings", "software_update_settings", SoftwareUpdateSettingsComponentSchema, func() ComponentConverter { return &SoftwareUpdateSettingsComponent{} }},
+	{"com.jamf.ddm-configuration-profile", "Legacy Payloads", "legacy_payloads", LegacyPayloadsComponentSchema, func() ComponentConverter { return &LegacyPayloadsComponent{} }},
+	// Future components can be added here by following the template in README.md.
+}
+
+// LookupComponentRegistry finds a strongly-typed component by identifier or resource block name.
+func LookupComponentRegistry(name string) (ComponentRegistry, bool) {
+	for _, registry := range CommonComponentRegistries {
+		if registry.identifier == name || registry.blockName == name {
+			return registry, true
+		}
+	}
+	return ComponentRegistry{}, false
 }
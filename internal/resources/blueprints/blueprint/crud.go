@@ -4,9 +4,14 @@ package blueprint
 
 import (
 	"context"
+	"time"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/poll"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -19,24 +24,32 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	var deviceGroupsSet []string
-	diags := data.DeviceGroups.ElementsAs(ctx, &deviceGroupsSet, false)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	allComponents, diags := r.collectAllComponents(ctx, &data)
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var deviceGroupsSet []string
+	diags = data.DeviceGroups.ElementsAs(ctx, &deviceGroupsSet, false)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	steps := []client.BlueprintStepV1{
-		{
-			Name:       "Declaration group",
-			Components: allComponents,
-		},
+	steps, diags := r.buildSteps(ctx, &data)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	reqBody := &client.BlueprintCreateRequestV1{
@@ -57,25 +70,24 @@ func (r *BlueprintResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	err = r.client.DeployBlueprintV1(ctx, createResp.ID)
-	if err != nil {
-		resp.Diagnostics.AddWarning(
-			"Blueprint deployment failed",
-			"Blueprint was created successfully but may not have been deployed: "+err.Error()+
-				". The blueprint may have been deployed despite the error. Check your Jamf instance to verify the blueprint status.",
-		)
-	}
+	// Record the blueprint right away so a failed deployment or read does not
+	// leave it untracked; Terraform then marks it as tainted.
+	data.ID = types.StringValue(createResp.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
-	blueprint, err := r.client.GetBlueprintByIDV1(ctx, createResp.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading created blueprint",
-			"Could not read created blueprint: "+err.Error(),
-		)
-		return
+	blueprint := r.deployBlueprint(ctx, &data, createResp.ID, "", interval, "created", &resp.Diagnostics)
+	if blueprint == nil {
+		blueprint, err = r.readBlueprintAfterApply(ctx, createResp.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading created blueprint",
+				"Could not read created blueprint: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	updateModelFromAPIResponse(&data, blueprint)
+	resp.Diagnostics.Append(updateModelAfterApply(&data, blueprint)...)
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -93,7 +105,7 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	blueprint, err := r.client.GetBlueprintByIDV1(ctx, data.ID.ValueString())
 	if err != nil {
-		if isNotFoundError(err) {
+		if client.IsNotFound(err) {
 			tflog.Info(ctx, "Blueprint not found, removing from state", map[string]interface{}{
 				"blueprint_id": data.ID.ValueString(),
 			})
@@ -108,7 +120,13 @@ func (r *BlueprintResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	updateModelFromAPIResponse(&data, blueprint)
+	resp.Diagnostics.Append(updateModelFromAPIResponse(&data, blueprint)...)
+
+	// State written before deploy existed has no value; record the default so
+	// the next plan does not update and redeploy every blueprint.
+	if data.Deploy.IsNull() {
+		data.Deploy = types.BoolValue(true)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -122,36 +140,53 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	var deviceGroupsSet []string
-	diags := data.DeviceGroups.ElementsAs(ctx, &deviceGroupsSet, false)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	allComponents, diags := r.collectAllComponents(ctx, &data)
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state BlueprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := r.updateRequestFromModel(ctx, &data)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	steps := []client.BlueprintStepV1{
-		{
-			Name:       "Declaration group",
-			Components: allComponents,
-		},
+	// Settings such as wait_for_deployment, poll_interval and timeouts only
+	// affect how the provider applies changes. When nothing else changed the
+	// blueprint is neither updated nor redeployed.
+	changed, diags := r.blueprintSettingsChanged(ctx, &data, &state)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
 	}
-
-	updateReq := &client.BlueprintUpdateRequestV1{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		Scope: client.BlueprintUpdateScopeV1{
-			DeviceGroups: deviceGroupsSet,
-		},
-		Steps: steps,
+	if !changed {
+		tflog.Debug(ctx, "Only local settings changed, skipping blueprint update", map[string]interface{}{
+			"blueprint_id": state.ID.ValueString(),
+		})
+		state.WaitForDeployment = data.WaitForDeployment
+		state.PollInterval = data.PollInterval
+		state.Timeouts = data.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
 	}
 
-	err := r.client.UpdateBlueprintV1(ctx, data.ID.ValueString(), updateReq)
+	err = r.client.UpdateBlueprintV1(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating blueprint",
@@ -160,25 +195,19 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	err = r.client.DeployBlueprintV1(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddWarning(
-			"Blueprint deployment failed",
-			"Blueprint was updated successfully but may not have been deployed: "+err.Error()+
-				". The blueprint may have been deployed despite the error. Check your Jamf instance to verify the blueprint status.",
-		)
-	}
-
-	blueprint, err := r.client.GetBlueprintByIDV1(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading updated blueprint",
-			"Could not read updated blueprint: "+err.Error(),
-		)
-		return
+	blueprint := r.deployBlueprint(ctx, &data, data.ID.ValueString(), state.LastDeploymentStarted.ValueString(), interval, "updated", &resp.Diagnostics)
+	if blueprint == nil {
+		blueprint, err = r.readBlueprintAfterApply(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading updated blueprint",
+				"Could not read updated blueprint: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	updateModelFromAPIResponse(&data, blueprint)
+	resp.Diagnostics.Append(updateModelAfterApply(&data, blueprint)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -197,16 +226,27 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteBlueprintV1(ctx, data.ID.ValueString())
 	if err != nil {
-		if isNotFoundError(err) {
+		if client.IsNotFound(err) {
 			tflog.Info(ctx, "Blueprint already deleted", map[string]interface{}{
 				"blueprint_id": data.ID.ValueString(),
 			})
 			return
 		}
 
-		if isServerError(err) {
+		// A 500 may be returned after the blueprint was deleted. Gateway and
+		// unavailable responses fail the delete so the blueprint stays in state.
+		if client.IsInternalServerError(err) {
 			resp.Diagnostics.AddWarning(
 				"Blueprint deletion encountered server error",
 				"Delete operation encountered a server error: "+err.Error()+
@@ -222,3 +262,56 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// deployBlueprint triggers a deployment unless deploy is false and, when
+// wait_for_deployment is set, waits for it to finish. Deployment problems are
+// reported as warnings unless waiting was requested, in which case they fail
+// the apply. The last blueprint observed while waiting is returned, or nil
+// when the caller should read it.
+func (r *BlueprintResource) deployBlueprint(ctx context.Context, data *BlueprintResourceModel, id, previousStarted string, interval time.Duration, action string, diags *diag.Diagnostics) *client.BlueprintDetailV1 {
+	if !data.Deploy.IsNull() && !data.Deploy.IsUnknown() && !data.Deploy.ValueBool() {
+		tflog.Debug(ctx, "Skipping blueprint deployment", map[string]interface{}{"blueprint_id": id})
+		return nil
+	}
+
+	wait := data.WaitForDeployment.ValueBool()
+
+	err := r.client.DeployBlueprintV1(ctx, id)
+	if err != nil {
+		if wait {
+			diags.AddError(
+				"Blueprint deployment failed",
+				"Blueprint was "+action+" successfully but could not be deployed: "+err.Error(),
+			)
+			return nil
+		}
+		diags.AddWarning(
+			"Blueprint deployment failed",
+			"Blueprint was "+action+" successfully but may not have been deployed: "+err.Error()+
+				". The blueprint may have been deployed despite the error. Check your Jamf instance to verify the blueprint status.",
+		)
+		return nil
+	}
+
+	if !wait {
+		return nil
+	}
+
+	blueprint, err := WaitForBlueprintDeployment(ctx, r.client, id, previousStarted, interval)
+	if err != nil {
+		diags.AddError(
+			"Blueprint deployment failed",
+			"Blueprint was "+action+" successfully but its deployment did not succeed: "+err.Error(),
+		)
+	}
+	return blueprint
+}
+
+// readBlueprintAfterApply reads a blueprint after it was created or updated.
+// Waiting for a deployment may have used up the operation timeout, so the read
+// runs on a fresh context with its own timeout.
+func (r *BlueprintResource) readBlueprintAfterApply(ctx context.Context, id string) (*client.BlueprintDetailV1, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), readAfterApplyTimeout)
+	defer cancel()
+	return r.client.GetBlueprintByIDV1(ctx, id)
+}
@@ -4,7 +4,6 @@ package blueprint
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/jsontypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -67,7 +67,7 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:    true,
 			},
 			"component": schema.ListNestedAttribute{
-				Description: "Blueprint components.",
+				Description: "Blueprint components from all steps, in step order.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -80,6 +80,11 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							ElementType: types.StringType,
 							Computed:    true,
 						},
+						"configuration_json": schema.StringAttribute{
+							Description: "Component configuration exactly as returned by the API, as a JSON document.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -138,6 +143,13 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Blueprint not found",
+				err.Error(),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to get blueprint",
 			err.Error(),
@@ -148,23 +160,11 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 	deviceGroupsSet, _ := types.SetValueFrom(context.Background(), types.StringType, bp.Scope.DeviceGroups)
 
 	var components []ComponentModel
-	if len(bp.Steps) > 0 {
-		step := bp.Steps[0]
-		components = make([]ComponentModel, len(step.Components))
-		for i, comp := range step.Components {
-			configMap := make(map[string]string)
-			if comp.Configuration != nil {
-				var jsonObj map[string]interface{}
-				if err := json.Unmarshal(comp.Configuration, &jsonObj); err == nil {
-					flattenJSON(jsonObj, "", configMap)
-				}
-			}
-
-			configMapValue, _ := types.MapValueFrom(context.Background(), types.StringType, configMap)
-			components[i] = ComponentModel{
-				Identifier:    types.StringValue(comp.Identifier),
-				Configuration: configMapValue,
-			}
+	for _, step := range bp.Steps {
+		for _, comp := range step.Components {
+			component := rawComponentFromAPI(comp, false)
+			component.Configuration = configurationMapFromAPI(comp)
+			components = append(components, component)
 		}
 	}
 
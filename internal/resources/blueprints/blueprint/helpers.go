@@ -3,19 +3,132 @@
 package blueprint
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/jsontypes"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint/components"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Default timeouts used when not configured.
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+)
+
+// readAfterApplyTimeout bounds the read that records a blueprint after create
+// or update, which may run after the operation timeout has expired.
+const readAfterApplyTimeout = 1 * time.Minute
+
+// defaultStepName is the name of the single step used when no step blocks are configured.
+const defaultStepName = "Declaration group"
+
+// importNamePrefix marks an import ID as a blueprint name rather than a blueprint ID.
+const importNamePrefix = "name:"
+
+// legacyPayloadsIdentifier is the component identifier used for legacy configuration profile payloads.
+const legacyPayloadsIdentifier = "com.jamf.ddm-configuration-profile"
+
+// Deployment states reported by the API, grouped by outcome. Values are compared case-insensitively.
+var (
+	deploymentInProgressStates = map[string]bool{"PENDING": true, "QUEUED": true, "STARTED": true, "RUNNING": true, "IN_PROGRESS": true, "DEPLOYING": true}
+	deploymentSucceededStates  = map[string]bool{"SUCCEEDED": true, "SUCCESS": true, "SUCCESSFUL": true, "COMPLETED": true, "DEPLOYED": true}
+	deploymentFailedStates     = map[string]bool{"FAILED": true, "FAILURE": true, "ERROR": true, "DEPLOYMENT_FAILED": true}
+)
+
+// WaitForBlueprintDeployment polls the blueprint until the deployment started
+// after previousStarted reaches a terminal state or the context is canceled.
+// The last observed blueprint is returned alongside any error so callers can
+// still record state after a failed or timed out deployment.
+func WaitForBlueprintDeployment(ctx context.Context, c *client.Client, id, previousStarted string, interval time.Duration) (*client.BlueprintDetailV1, error) {
+	var last *client.BlueprintDetailV1
+	for {
+		select {
+		case <-ctx.Done():
+			return last, deploymentWaitError(ctx, id, last)
+		case <-time.After(interval):
+			blueprint, err := c.GetBlueprintByIDV1(ctx, id)
+			if err != nil {
+				if ctx.Err() != nil {
+					return last, deploymentWaitError(ctx, id, last)
+				}
+				return last, fmt.Errorf("failed to poll blueprint %s: %w", id, err)
+			}
+			last = blueprint
+
+			state := blueprint.DeploymentState
+			fields := map[string]interface{}{"blueprint_id": id, "deployment_state": state.State}
+			if state.LastDeployment != nil {
+				fields["last_deployment_started"] = state.LastDeployment.Started
+				fields["last_deployment_state"] = state.LastDeployment.State
+			}
+			tflog.Debug(ctx, "blueprint deployment state", fields)
+
+			if state.LastDeployment == nil || state.LastDeployment.Started == previousStarted {
+				continue
+			}
+
+			lastState := strings.ToUpper(state.LastDeployment.State)
+			overallState := strings.ToUpper(state.State)
+			switch {
+			case deploymentFailedStates[lastState] || deploymentFailedStates[overallState]:
+				return blueprint, fmt.Errorf("deployment of blueprint %s started at %s finished in state %s (blueprint deployment state: %s)",
+					id, state.LastDeployment.Started, state.LastDeployment.State, state.State)
+			case deploymentSucceededStates[lastState] && !deploymentInProgressStates[overallState]:
+				return blueprint, nil
+			}
+		}
+	}
+}
+
+// deploymentWaitError describes why a deployment wait ended early, including the last observed state.
+func deploymentWaitError(ctx context.Context, id string, last *client.BlueprintDetailV1) error {
+	lastState := "unknown"
+	if last != nil {
+		lastState = last.DeploymentState.State
+		if last.DeploymentState.LastDeployment != nil {
+			lastState += ", last deployment: " + last.DeploymentState.LastDeployment.State
+		}
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for blueprint %s to deploy; last observed deployment state: %s", id, lastState)
+	}
+	return fmt.Errorf("canceled while waiting for blueprint %s to deploy (last observed deployment state: %s): %w", id, lastState, ctx.Err())
+}
+
 // updateModelFromAPIResponse updates the Terraform model with data from the API response.
-func updateModelFromAPIResponse(model *BlueprintResourceModel, blueprint *client.BlueprintDetailV1) {
+// Components are reconciled against every component in the API response, so
+// components added, removed or changed outside Terraform show up as differences
+// in the next plan. Components that can no longer be read into their typed block
+// are reported as warnings.
+func updateModelFromAPIResponse(model *BlueprintResourceModel, blueprint *client.BlueprintDetailV1) diag.Diagnostics {
+	return updateModel(model, blueprint, true)
+}
+
+// updateModelAfterApply updates the planned model with data from the API
+// response after a create or update. The planned steps and component blocks are
+// kept, because the result of an apply must match the plan; drift is only
+// reconciled by Read.
+func updateModelAfterApply(model *BlueprintResourceModel, blueprint *client.BlueprintDetailV1) diag.Diagnostics {
+	return updateModel(model, blueprint, false)
+}
+
+// updateModel updates the model from the API response, reconciling the steps
+// and components with the API when reconcile is set.
+func updateModel(model *BlueprintResourceModel, blueprint *client.BlueprintDetailV1, reconcile bool) diag.Diagnostics {
 	model.ID = types.StringValue(blueprint.ID)
 	model.Name = types.StringValue(blueprint.Name)
 
@@ -28,67 +141,370 @@ func updateModelFromAPIResponse(model *BlueprintResourceModel, blueprint *client
 	model.Created = types.StringValue(blueprint.Created)
 	model.Updated = types.StringValue(blueprint.Updated)
 	model.DeploymentState = types.StringValue(blueprint.DeploymentState.State)
+	if last := blueprint.DeploymentState.LastDeployment; last != nil {
+		model.LastDeploymentStarted = types.StringValue(last.Started)
+		model.LastDeploymentState = types.StringValue(last.State)
+	} else {
+		model.LastDeploymentStarted = types.StringNull()
+		model.LastDeploymentState = types.StringNull()
+	}
 
 	deviceGroupsSet, _ := types.SetValueFrom(context.Background(), types.StringType, blueprint.Scope.DeviceGroups)
 	model.DeviceGroups = deviceGroupsSet
 
-	if len(blueprint.Steps) > 0 {
-		step := blueprint.Steps[0]
+	var diags diag.Diagnostics
+	if !reconcile {
+		if len(model.Steps) > 0 {
+			for i := range model.Steps {
+				if i < len(blueprint.Steps) {
+					updateStepComponentsFromAPI(&model.Steps[i].StepComponentsModel, blueprint.Steps[i], false, &diags)
+				}
+			}
+		} else if len(blueprint.Steps) > 0 {
+			updateStepComponentsFromAPI(&model.StepComponentsModel, blueprint.Steps[0], false, &diags)
+		}
+		return diags
+	}
+
+	// Steps added or renamed outside Terraform cannot be represented by the
+	// top-level component blocks, so the blueprint is recorded with step blocks.
+	if len(model.Steps) > 0 || !isDefaultSingleStep(blueprint.Steps) {
+		model.StepComponentsModel = emptyStepComponents()
+		model.Steps = updateStepsFromAPI(model.Steps, blueprint.Steps, &diags)
+		return diags
+	}
+
+	if len(blueprint.Steps) == 0 {
+		model.StepComponentsModel = emptyStepComponents()
+		return nil
+	}
+
+	updateStepComponentsFromAPI(&model.StepComponentsModel, blueprint.Steps[0], true, &diags)
+	return diags
+}
+
+// ModelFromAPI returns the model that importing the blueprint produces. It is
+// used to generate configuration for existing blueprints.
+func ModelFromAPI(blueprint *client.BlueprintDetailV1) (BlueprintResourceModel, diag.Diagnostics) {
+	var model BlueprintResourceModel
+	diags := updateModelFromAPIResponse(&model, blueprint)
+	return model, diags
+}
+
+// TypedComponents returns the strongly-typed components of the step in block order.
+func (m *StepComponentsModel) TypedComponents() []components.ComponentConverter {
+	var typed []components.ComponentConverter
+	for i := range m.AudioAccessorySettings {
+		typed = append(typed, &m.AudioAccessorySettings[i])
+	}
+	for i := range m.DiskManagementSettings {
+		typed = append(typed, &m.DiskManagementSettings[i])
+	}
+	for i := range m.MathSettings {
+		typed = append(typed, &m.MathSettings[i])
+	}
+	for i := range m.PasscodePolicy {
+		typed = append(typed, &m.PasscodePolicy[i])
+	}
+	for i := range m.SafariBookmarks {
+		typed = append(typed, &m.SafariBookmarks[i])
+	}
+	for i := range m.SafariExtensions {
+		typed = append(typed, &m.SafariExtensions[i])
+	}
+	for i := range m.SafariSettings {
+		typed = append(typed, &m.SafariSettings[i])
+	}
+	for i := range m.ServiceBackgroundTasks {
+		typed = append(typed, &m.ServiceBackgroundTasks[i])
+	}
+	for i := range m.ServiceConfigurationFiles {
+		typed = append(typed, &m.ServiceConfigurationFiles[i])
+	}
+	for i := range m.SoftwareUpdate {
+		typed = append(typed, &m.SoftwareUpdate[i])
+	}
+	for i := range m.SoftwareUpdateSettings {
+		typed = append(typed, &m.SoftwareUpdateSettings[i])
+	}
+	return typed
+}
+
+// isDefaultSingleStep reports whether the API steps can be represented by top-level component blocks.
+func isDefaultSingleStep(steps []client.BlueprintStepV1) bool {
+	return len(steps) == 0 || (len(steps) == 1 && steps[0].Name == defaultStepName)
+}
 
-		apiComponentsByID := make(map[string]client.BlueprintComponentV1)
-		for _, comp := range step.Components {
-			apiComponentsByID[comp.Identifier] = comp
+// updateStepsFromAPI updates the configured steps from the API steps, preserving
+// their order. Steps that exist only in the API, such as on import, are added
+// with their components built from the API response.
+func updateStepsFromAPI(modelSteps []StepModel, apiSteps []client.BlueprintStepV1, diags *diag.Diagnostics) []StepModel {
+	steps := make([]StepModel, len(apiSteps))
+	for i, apiStep := range apiSteps {
+		if i < len(modelSteps) {
+			steps[i] = modelSteps[i]
+			updateStepComponentsFromAPI(&steps[i].StepComponentsModel, apiStep, true, diags)
+		} else {
+			steps[i].StepComponentsModel = stepComponentsFromAPI(apiStep)
 		}
+		steps[i].Name = types.StringValue(apiStep.Name)
+	}
+	return steps
+}
 
-		components := make([]ComponentModel, len(model.Components))
-		for i, modelComp := range model.Components {
-			identifier := modelComp.Identifier.ValueString()
-
-			if apiComp, exists := apiComponentsByID[identifier]; exists {
-				configMap := make(map[string]string)
-				if apiComp.Configuration != nil {
-					var jsonObj map[string]interface{}
-					if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err == nil {
-						flattenJSON(jsonObj, "", configMap)
-					}
-				}
+// updateStepComponentsFromAPI updates the configured components of a step from
+// the matching API step. Configured components are matched to API components by
+// identifier, in order. When reconcile is set, configured components missing from
+// the API are removed and API components that are not configured are added as raw
+// components; otherwise the configured components are kept.
+func updateStepComponentsFromAPI(model *StepComponentsModel, step client.BlueprintStepV1, reconcile bool, diags *diag.Diagnostics) {
+	if model.isEmpty() {
+		if reconcile {
+			*model = stepComponentsFromAPI(step)
+		}
+		return
+	}
+
+	pending := newAPIComponentQueue(step.Components)
+
+	components := make([]ComponentModel, 0, len(model.Components))
+	for _, modelComp := range model.Components {
+		apiComp, exists := pending.take(modelComp.Identifier.ValueString())
+		switch {
+		case !exists && reconcile:
+			continue
+		case !exists:
+			components = append(components, modelComp)
+		case !modelComp.ConfigurationJSON.IsNull():
+			components = append(components, rawComponentFromAPI(apiComp, false))
+		case !modelComp.Configuration.IsNull():
+			components = append(components, rawComponentFromAPI(apiComp, true))
+		default:
+			components = append(components, modelComp)
+		}
+	}
+	model.Components = components
 
-				configMapValue, _ := types.MapValueFrom(context.Background(), types.StringType, configMap)
-				components[i] = ComponentModel{
-					Identifier:    types.StringValue(apiComp.Identifier),
-					Configuration: configMapValue,
+	updateStronglyTypedComponentsFromAPI(model, pending, step.Name, reconcile, diags)
+	if !reconcile {
+		return
+	}
+
+	for _, apiComp := range pending.remaining() {
+		model.Components = append(model.Components, rawComponentFromAPI(apiComp, false))
+	}
+}
+
+// apiComponentQueue hands out the components of an API step by identifier, in
+// order, so that each API component is matched to at most one configured component.
+type apiComponentQueue struct {
+	components []client.BlueprintComponentV1
+	taken      []bool
+}
+
+// newAPIComponentQueue returns a queue over the components of an API step.
+func newAPIComponentQueue(components []client.BlueprintComponentV1) *apiComponentQueue {
+	return &apiComponentQueue{
+		components: components,
+		taken:      make([]bool, len(components)),
+	}
+}
+
+// take returns the first component with the given identifier that has not been taken yet.
+func (q *apiComponentQueue) take(identifier string) (client.BlueprintComponentV1, bool) {
+	for i, comp := range q.components {
+		if !q.taken[i] && comp.Identifier == identifier {
+			q.taken[i] = true
+			return comp, true
+		}
+	}
+	return client.BlueprintComponentV1{}, false
+}
+
+// remaining returns the components that have not been taken, in API order.
+func (q *apiComponentQueue) remaining() []client.BlueprintComponentV1 {
+	var remaining []client.BlueprintComponentV1
+	for i, comp := range q.components {
+		if !q.taken[i] {
+			remaining = append(remaining, comp)
+		}
+	}
+	return remaining
+}
+
+// stepComponentsFromAPI builds the components of a step that has no configuration yet, such as on import.
+// Legacy payloads populate legacy_payloads and components with a strongly-typed block populate that block.
+// Every other component is represented as a raw component.
+func stepComponentsFromAPI(step client.BlueprintStepV1) StepComponentsModel {
+	identifierCounts := make(map[string]int)
+	for _, apiComp := range step.Components {
+		identifierCounts[apiComp.Identifier]++
+	}
+
+	model := emptyStepComponents()
+	for _, apiComp := range step.Components {
+		if identifierCounts[apiComp.Identifier] == 1 {
+			if apiComp.Identifier == legacyPayloadsIdentifier {
+				if payloads, ok := legacyPayloadsFromAPI(apiComp); ok && model.LegacyPayloads.IsNull() {
+					model.LegacyPayloads = jsontypes.NewNormalizedValue(payloads)
+					continue
 				}
-			} else {
-				components[i] = modelComp
+			} else if component, ok := typedComponentFromAPI(apiComp); ok && model.appendTypedComponent(component) {
+				continue
 			}
 		}
-		model.Components = components
-		updateStronglyTypedComponentsFromAPI(model, apiComponentsByID)
+		model.Components = append(model.Components, rawComponentFromAPI(apiComp, false))
+	}
+	return model
+}
 
-	} else {
-		model.Components = []ComponentModel{}
-		model.AudioAccessorySettings = []components.AudioAccessorySettingsComponent{}
-		model.DiskManagementSettings = []components.DiskManagementPolicyComponent{}
+// typedComponentFromAPI converts an API component into its strongly-typed block.
+// It only succeeds when the typed block renders back to exactly the same
+// configuration, so that the imported state does not produce a diff.
+func typedComponentFromAPI(apiComp client.BlueprintComponentV1) (components.ComponentConverter, bool) {
+	registry, ok := components.LookupComponentRegistry(apiComp.Identifier)
+	if !ok || registry.Identifier() != apiComp.Identifier || apiComp.Configuration == nil {
+		return nil, false
+	}
+
+	var jsonObj map[string]interface{}
+	if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err != nil {
+		return nil, false
+	}
+
+	component := registry.NewComponent()
+	if err := component.FromRawConfiguration(jsonObj); err != nil {
+		return nil, false
+	}
+
+	rendered, err := component.ToRawConfiguration()
+	if err != nil {
+		return nil, false
+	}
+	renderedJSON, err := json.Marshal(rendered)
+	if err != nil || jsontypes.Normalize(string(renderedJSON)) != jsontypes.Normalize(string(apiComp.Configuration)) {
+		return nil, false
+	}
+
+	return component, true
+}
+
+// appendTypedComponent adds a strongly-typed component to its block. It reports
+// false for components that have no block on the resource.
+func (m *StepComponentsModel) appendTypedComponent(component components.ComponentConverter) bool {
+	switch c := component.(type) {
+	case *components.AudioAccessorySettingsComponent:
+		m.AudioAccessorySettings = append(m.AudioAccessorySettings, *c)
+	case *components.DiskManagementPolicyComponent:
+		m.DiskManagementSettings = append(m.DiskManagementSettings, *c)
+	case *components.MathSettingsComponent:
+		m.MathSettings = append(m.MathSettings, *c)
+	case *components.PasscodePolicyComponent:
+		m.PasscodePolicy = append(m.PasscodePolicy, *c)
+	case *components.SafariBookmarksComponent:
+		m.SafariBookmarks = append(m.SafariBookmarks, *c)
+	case *components.SafariExtensionsComponent:
+		m.SafariExtensions = append(m.SafariExtensions, *c)
+	case *components.SafariSettingsComponent:
+		m.SafariSettings = append(m.SafariSettings, *c)
+	case *components.ServiceBackgroundTasksComponent:
+		m.ServiceBackgroundTasks = append(m.ServiceBackgroundTasks, *c)
+	case *components.ServiceConfigurationFilesComponent:
+		m.ServiceConfigurationFiles = append(m.ServiceConfigurationFiles, *c)
+	case *components.SoftwareUpdateComponent:
+		m.SoftwareUpdate = append(m.SoftwareUpdate, *c)
+	case *components.SoftwareUpdateSettingsComponent:
+		m.SoftwareUpdateSettings = append(m.SoftwareUpdateSettings, *c)
+	default:
+		return false
+	}
+	return true
+}
+
+// emptyStepComponents returns a step with no components configured.
+func emptyStepComponents() StepComponentsModel {
+	return StepComponentsModel{
+		Components:                []ComponentModel{},
+		AudioAccessorySettings:    []components.AudioAccessorySettingsComponent{},
+		DiskManagementSettings:    []components.DiskManagementPolicyComponent{},
+		MathSettings:              []components.MathSettingsComponent{},
+		PasscodePolicy:            []components.PasscodePolicyComponent{},
+		SafariBookmarks:           []components.SafariBookmarksComponent{},
+		SafariExtensions:          []components.SafariExtensionsComponent{},
+		SafariSettings:            []components.SafariSettingsComponent{},
+		ServiceBackgroundTasks:    []components.ServiceBackgroundTasksComponent{},
+		ServiceConfigurationFiles: []components.ServiceConfigurationFilesComponent{},
+		SoftwareUpdate:            []components.SoftwareUpdateComponent{},
+		SoftwareUpdateSettings:    []components.SoftwareUpdateSettingsComponent{},
+		LegacyPayloads:            jsontypes.NewNormalizedNull(),
 	}
 }
 
-// normalizeJSON takes a JSON string and returns it with sorted keys to ensure consistent comparison
-func normalizeJSON(jsonStr string) string {
-	if jsonStr == "" {
-		return ""
+// rawComponentFromAPI converts an API component into a raw component. The
+// configuration is stored losslessly in configuration_json unless useMap is
+// set, in which case it is flattened into the configuration map.
+func rawComponentFromAPI(apiComp client.BlueprintComponentV1, useMap bool) ComponentModel {
+	component := ComponentModel{
+		Identifier:        types.StringValue(apiComp.Identifier),
+		Configuration:     types.MapNull(types.StringType),
+		ConfigurationJSON: jsontypes.NewNormalizedNull(),
 	}
 
-	var obj interface{}
-	if err := json.Unmarshal([]byte(jsonStr), &obj); err != nil {
-		return jsonStr
+	if useMap {
+		component.Configuration = configurationMapFromAPI(apiComp)
+	} else if apiComp.Configuration != nil {
+		component.ConfigurationJSON = jsontypes.NewNormalizedValue(string(apiComp.Configuration))
+	}
+
+	return component
+}
+
+// configurationMapFromAPI flattens an API component configuration into key-value pairs.
+func configurationMapFromAPI(apiComp client.BlueprintComponentV1) types.Map {
+	configMap := make(map[string]string)
+	if apiComp.Configuration != nil {
+		var jsonObj map[string]interface{}
+		if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err == nil {
+			flattenJSON(jsonObj, "", configMap)
+		}
 	}
 
-	normalized, err := json.Marshal(obj)
+	configMapValue, _ := types.MapValueFrom(context.Background(), types.StringType, configMap)
+	return configMapValue
+}
+
+// legacyPayloadsFromAPI returns the JSON-encoded payloadContent of a legacy payloads component.
+func legacyPayloadsFromAPI(apiComp client.BlueprintComponentV1) (string, bool) {
+	var jsonObj map[string]interface{}
+	if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err != nil {
+		return "", false
+	}
+	payloadContent, exists := jsonObj["payloadContent"]
+	if !exists {
+		return "", false
+	}
+	payloadJSON, err := json.Marshal(payloadContent)
 	if err != nil {
-		return jsonStr
+		return "", false
 	}
+	return string(payloadJSON), true
+}
 
-	return string(normalized)
+// isEmpty reports whether no components or legacy payloads are configured.
+func (m StepComponentsModel) isEmpty() bool {
+	return len(m.Components) == 0 &&
+		len(m.AudioAccessorySettings) == 0 &&
+		len(m.DiskManagementSettings) == 0 &&
+		len(m.MathSettings) == 0 &&
+		len(m.PasscodePolicy) == 0 &&
+		len(m.SafariBookmarks) == 0 &&
+		len(m.SafariExtensions) == 0 &&
+		len(m.SafariSettings) == 0 &&
+		len(m.ServiceBackgroundTasks) == 0 &&
+		len(m.ServiceConfigurationFiles) == 0 &&
+		len(m.SoftwareUpdate) == 0 &&
+		len(m.SoftwareUpdateSettings) == 0 &&
+		(m.LegacyPayloads.IsNull() || m.LegacyPayloads.IsUnknown())
 }
 
 // setNestedValue sets a value in a nested map structure using underscore notation
@@ -164,29 +580,74 @@ func flattenJSON(obj map[string]interface{}, prefix string, result map[string]st
 	}
 }
 
-// isNotFoundError checks if the error is a 404 not found error
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
+// updateRequestFromModel builds the update request body from the Terraform model.
+func (r *BlueprintResource) updateRequestFromModel(ctx context.Context, data *BlueprintResourceModel) (*client.BlueprintUpdateRequestV1, diag.Diagnostics) {
+	var deviceGroups []string
+	diags := data.DeviceGroups.ElementsAs(ctx, &deviceGroups, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	sort.Strings(deviceGroups)
+
+	steps, stepDiags := r.buildSteps(ctx, data)
+	diags.Append(stepDiags...)
+	if diags.HasError() {
+		return nil, diags
 	}
-	errorStr := err.Error()
-	return strings.Contains(errorStr, "status 404") ||
-		strings.Contains(errorStr, "was not found") ||
-		strings.Contains(errorStr, "NOT_FOUND")
+
+	return &client.BlueprintUpdateRequestV1{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Scope: client.BlueprintUpdateScopeV1{
+			DeviceGroups: deviceGroups,
+		},
+		Steps: steps,
+	}, diags
 }
 
-// isServerError checks if the error is a server error (500)
-func isServerError(err error) bool {
-	if err == nil {
-		return false
+// blueprintSettingsChanged reports whether the planned blueprint differs from
+// the prior state in anything sent to the API or in whether it is deployed.
+func (r *BlueprintResource) blueprintSettingsChanged(ctx context.Context, plan, state *BlueprintResourceModel) (bool, diag.Diagnostics) {
+	if !plan.Deploy.Equal(state.Deploy) {
+		return true, nil
+	}
+	planned, diags := r.updateRequestFromModel(ctx, plan)
+	prior, priorDiags := r.updateRequestFromModel(ctx, state)
+	diags.Append(priorDiags...)
+	if diags.HasError() {
+		return false, diags
 	}
-	errorStr := err.Error()
-	return strings.Contains(errorStr, "status 500") ||
-		strings.Contains(errorStr, "Internal Server Error")
+	return !reflect.DeepEqual(planned, prior), diags
+}
+
+// buildSteps converts the configured steps, or the top-level components when no
+// step blocks are configured, into API steps.
+func (r *BlueprintResource) buildSteps(ctx context.Context, data *BlueprintResourceModel) ([]client.BlueprintStepV1, diag.Diagnostics) {
+	if len(data.Steps) == 0 {
+		allComponents, diags := r.collectAllComponents(ctx, &data.StepComponentsModel, data.Name.ValueString())
+		return []client.BlueprintStepV1{
+			{
+				Name:       defaultStepName,
+				Components: allComponents,
+			},
+		}, diags
+	}
+
+	var diags diag.Diagnostics
+	steps := make([]client.BlueprintStepV1, 0, len(data.Steps))
+	for i := range data.Steps {
+		allComponents, stepDiags := r.collectAllComponents(ctx, &data.Steps[i].StepComponentsModel, data.Name.ValueString())
+		diags.Append(stepDiags...)
+		steps = append(steps, client.BlueprintStepV1{
+			Name:       data.Steps[i].Name.ValueString(),
+			Components: allComponents,
+		})
+	}
+	return steps, diags
 }
 
 // collectAllComponents gathers components from both raw and strongly-typed sources
-func (r *BlueprintResource) collectAllComponents(ctx context.Context, data *BlueprintResourceModel) ([]client.BlueprintComponentV1, diag.Diagnostics) {
+func (r *BlueprintResource) collectAllComponents(ctx context.Context, data *StepComponentsModel, blueprintName string) ([]client.BlueprintComponentV1, diag.Diagnostics) {
 	var allComponents []client.BlueprintComponentV1
 	var diags diag.Diagnostics
 
@@ -195,7 +656,17 @@ func (r *BlueprintResource) collectAllComponents(ctx context.Context, data *Blue
 			Identifier: comp.Identifier.ValueString(),
 		}
 
-		if !comp.Configuration.IsNull() && !comp.Configuration.IsUnknown() {
+		if !comp.ConfigurationJSON.IsNull() && !comp.ConfigurationJSON.IsUnknown() {
+			var compacted bytes.Buffer
+			if err := json.Compact(&compacted, []byte(comp.ConfigurationJSON.ValueString())); err != nil {
+				diags.AddError(
+					"Error encoding component configuration",
+					"Could not parse configuration_json for component "+comp.Identifier.ValueString()+": "+err.Error(),
+				)
+				continue
+			}
+			component.Configuration = json.RawMessage(compacted.Bytes())
+		} else if !comp.Configuration.IsNull() && !comp.Configuration.IsUnknown() {
 			configMap := make(map[string]string)
 			configDiags := comp.Configuration.ElementsAs(ctx, &configMap, false)
 			if configDiags.HasError() {
@@ -217,19 +688,19 @@ func (r *BlueprintResource) collectAllComponents(ctx context.Context, data *Blue
 				continue
 			}
 
-			normalizedConfig := normalizeJSON(string(jsonBytes))
+			normalizedConfig := jsontypes.Normalize(string(jsonBytes))
 			component.Configuration = json.RawMessage(normalizedConfig)
 		}
 		allComponents = append(allComponents, component)
 	}
 
-	r.collectStronglyTypedComponents(&allComponents, &diags, data)
+	r.collectStronglyTypedComponents(&allComponents, &diags, data, blueprintName)
 
 	return allComponents, diags
 }
 
 // collectStronglyTypedComponents processes all strongly-typed components using a scalable approach
-func (r *BlueprintResource) collectStronglyTypedComponents(allComponents *[]client.BlueprintComponentV1, diags *diag.Diagnostics, data *BlueprintResourceModel) {
+func (r *BlueprintResource) collectStronglyTypedComponents(allComponents *[]client.BlueprintComponentV1, diags *diag.Diagnostics, data *StepComponentsModel, blueprintName string) {
 	for i := range data.AudioAccessorySettings {
 		r.collectSingleComponent(allComponents, diags, &data.AudioAccessorySettings[i], "audio accessory settings")
 	}
@@ -275,7 +746,7 @@ func (r *BlueprintResource) collectStronglyTypedComponents(allComponents *[]clie
 	}
 
 	if !data.LegacyPayloads.IsNull() && !data.LegacyPayloads.IsUnknown() {
-		r.collectLegacyPayloadsString(allComponents, diags, data.LegacyPayloads.ValueString(), data.Name.ValueString())
+		r.collectLegacyPayloadsString(allComponents, diags, data.LegacyPayloads.ValueString(), blueprintName)
 	}
 }
 
@@ -297,11 +768,11 @@ func (r *BlueprintResource) collectSingleComponent(allComponents *[]client.Bluep
 
 // collectLegacyPayloadsString is a special helper for legacy payloads from string attribute
 func (r *BlueprintResource) collectLegacyPayloadsString(allComponents *[]client.BlueprintComponentV1, diags *diag.Diagnostics, payloadContent string, blueprintName string) {
-	var payloadArray []interface{}
+	var payloadArray []json.RawMessage
 	if err := json.Unmarshal([]byte(payloadContent), &payloadArray); err != nil {
 		diags.AddError(
 			"Error parsing legacy payloads JSON",
-			"Could not parse payload_content as JSON array: "+err.Error(),
+			"Could not parse legacy_payloads as JSON array: "+err.Error(),
 		)
 		return
 	}
@@ -321,97 +792,104 @@ func (r *BlueprintResource) collectLegacyPayloadsString(allComponents *[]client.
 	}
 
 	*allComponents = append(*allComponents, client.BlueprintComponentV1{
-		Identifier:    "com.jamf.ddm-configuration-profile",
+		Identifier:    legacyPayloadsIdentifier,
 		Configuration: json.RawMessage(configJSON),
 	})
 }
 
-// updateStronglyTypedComponentsFromAPI updates all strongly-typed components from API response
-func updateStronglyTypedComponentsFromAPI(model *BlueprintResourceModel, apiComponentsByID map[string]client.BlueprintComponentV1) {
-	updateComponentsFromAPI("com.jamf.ddm.audio-accessory-settings", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.AudioAccessorySettings {
-			_ = model.AudioAccessorySettings[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.disk-management", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.DiskManagementSettings {
-			_ = model.DiskManagementSettings[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.math-settings", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.MathSettings {
-			_ = model.MathSettings[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.passcode-settings", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.PasscodePolicy {
-			_ = model.PasscodePolicy[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.safari-bookmarks", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.SafariBookmarks {
-			_ = model.SafariBookmarks[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.safari-extensions", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.SafariExtensions {
-			_ = model.SafariExtensions[i].FromRawConfiguration(jsonObj)
-		}
-	})
-
-	updateComponentsFromAPI("com.jamf.ddm.safari-settings", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.SafariSettings {
-			_ = model.SafariSettings[i].FromRawConfiguration(jsonObj)
-		}
-	})
+// updateStronglyTypedComponentsFromAPI updates all strongly-typed components from the API step.
+// When reconcile is set, typed blocks whose component was removed outside Terraform
+// are removed, and components that no longer parse into their block are recorded as
+// raw components.
+func updateStronglyTypedComponentsFromAPI(model *StepComponentsModel, pending *apiComponentQueue, stepName string, reconcile bool, diags *diag.Diagnostics) {
+	model.AudioAccessorySettings = updateTypedComponentsFromAPI(model, model.AudioAccessorySettings, pending, stepName, reconcile, diags)
+	model.DiskManagementSettings = updateTypedComponentsFromAPI(model, model.DiskManagementSettings, pending, stepName, reconcile, diags)
+	model.MathSettings = updateTypedComponentsFromAPI(model, model.MathSettings, pending, stepName, reconcile, diags)
+	model.PasscodePolicy = updateTypedComponentsFromAPI(model, model.PasscodePolicy, pending, stepName, reconcile, diags)
+	model.SafariBookmarks = updateTypedComponentsFromAPI(model, model.SafariBookmarks, pending, stepName, reconcile, diags)
+	model.SafariExtensions = updateTypedComponentsFromAPI(model, model.SafariExtensions, pending, stepName, reconcile, diags)
+	model.SafariSettings = updateTypedComponentsFromAPI(model, model.SafariSettings, pending, stepName, reconcile, diags)
+	model.ServiceBackgroundTasks = updateTypedComponentsFromAPI(model, model.ServiceBackgroundTasks, pending, stepName, reconcile, diags)
+	model.ServiceConfigurationFiles = updateTypedComponentsFromAPI(model, model.ServiceConfigurationFiles, pending, stepName, reconcile, diags)
+	model.SoftwareUpdate = updateTypedComponentsFromAPI(model, model.SoftwareUpdate, pending, stepName, reconcile, diags)
+	model.SoftwareUpdateSettings = updateTypedComponentsFromAPI(model, model.SoftwareUpdateSettings, pending, stepName, reconcile, diags)
+
+	if !reconcile || model.LegacyPayloads.IsNull() || model.LegacyPayloads.IsUnknown() {
+		return
+	}
+	apiComp, exists := pending.take(legacyPayloadsIdentifier)
+	if !exists {
+		model.LegacyPayloads = jsontypes.NewNormalizedNull()
+		return
+	}
+	payloads, ok := legacyPayloadsFromAPI(apiComp)
+	if !ok {
+		addComponentParseWarning(diags, "legacy_payloads", apiComp.Identifier, stepName, errors.New("configuration has no payloadContent array"))
+		model.LegacyPayloads = jsontypes.NewNormalizedNull()
+		model.Components = append(model.Components, rawComponentFromAPI(apiComp, false))
+		return
+	}
+	model.LegacyPayloads = jsontypes.NewNormalizedValue(payloads)
+}
 
-	updateComponentsFromAPI("com.jamf.ddm.service-background-tasks", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.ServiceBackgroundTasks {
-			_ = model.ServiceBackgroundTasks[i].FromRawConfiguration(jsonObj)
+// updateTypedComponentsFromAPI updates the blocks of one strongly-typed component
+// type from the API step and returns the blocks that are still present. Without
+// reconcile, blocks that are missing from the API or cannot be read are kept as
+// configured.
+func updateTypedComponentsFromAPI[T any, PT interface {
+	*T
+	components.ComponentConverter
+}](model *StepComponentsModel, blocks []T, pending *apiComponentQueue, stepName string, reconcile bool, diags *diag.Diagnostics) []T {
+	updated := make([]T, 0, len(blocks))
+	for i := range blocks {
+		parsed := blocks[i]
+		block := PT(&parsed)
+		apiComp, exists := pending.take(block.GetIdentifier())
+		if !exists {
+			if !reconcile {
+				updated = append(updated, blocks[i])
+			}
+			continue
 		}
-	})
 
-	updateComponentsFromAPI("com.jamf.ddm.service-configuration-files", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.ServiceConfigurationFiles {
-			_ = model.ServiceConfigurationFiles[i].FromRawConfiguration(jsonObj)
+		if err := typedComponentFromRaw(block, apiComp); err != nil {
+			if !reconcile {
+				updated = append(updated, blocks[i])
+				continue
+			}
+			blockName := block.GetIdentifier()
+			if registry, ok := components.LookupComponentRegistry(blockName); ok {
+				blockName = registry.BlockName()
+			}
+			addComponentParseWarning(diags, blockName, apiComp.Identifier, stepName, err)
+			model.Components = append(model.Components, rawComponentFromAPI(apiComp, false))
+			continue
 		}
-	})
+		updated = append(updated, parsed)
+	}
+	return updated
+}
 
-	updateComponentsFromAPI("com.jamf.ddm.sw-updates", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.SoftwareUpdate {
-			_ = model.SoftwareUpdate[i].FromRawConfiguration(jsonObj)
-		}
-	})
+// typedComponentFromRaw populates a typed component from the configuration of an API component.
+func typedComponentFromRaw(component components.ComponentConverter, apiComp client.BlueprintComponentV1) error {
+	if apiComp.Configuration == nil {
+		return errors.New("component has no configuration")
+	}
 
-	updateComponentsFromAPI("com.jamf.ddm.software-update-settings", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		for i := range model.SoftwareUpdateSettings {
-			_ = model.SoftwareUpdateSettings[i].FromRawConfiguration(jsonObj)
-		}
-	})
+	var jsonObj map[string]interface{}
+	if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err != nil {
+		return fmt.Errorf("configuration is not a JSON object: %w", err)
+	}
 
-	updateComponentsFromAPI("com.jamf.ddm-configuration-profile", apiComponentsByID, func(jsonObj map[string]interface{}) {
-		if !model.LegacyPayloads.IsNull() {
-			if payloadContent, exists := jsonObj["payloadContent"]; exists {
-				payloadJSON, err := json.Marshal(payloadContent)
-				if err == nil {
-					model.LegacyPayloads = types.StringValue(string(payloadJSON))
-				}
-			}
-		}
-	})
+	return component.FromRawConfiguration(jsonObj)
 }
 
-// updateComponentsFromAPI is a generic helper that updates components of any type from API response
-func updateComponentsFromAPI(identifier string, apiComponentsByID map[string]client.BlueprintComponentV1, updateFunc func(map[string]interface{})) {
-	if apiComp, exists := apiComponentsByID[identifier]; exists && apiComp.Configuration != nil {
-		var jsonObj map[string]interface{}
-		if err := json.Unmarshal(apiComp.Configuration, &jsonObj); err == nil {
-			updateFunc(jsonObj)
-		}
-	}
+// addComponentParseWarning reports a component that could not be read into its typed block.
+func addComponentParseWarning(diags *diag.Diagnostics, blockName, identifier, stepName string, err error) {
+	diags.AddWarning(
+		"Blueprint component could not be read",
+		fmt.Sprintf("Component %s in step %q could not be read into its %s block: %s. "+
+			"It has been recorded as a raw_component, so the next plan shows the difference to the configuration.",
+			identifier, stepName, blockName, err.Error()),
+	)
 }
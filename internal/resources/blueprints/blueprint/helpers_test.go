@@ -0,0 +1,97 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlueprintSettingsChanged(t *testing.T) {
+	ctx := context.Background()
+	r := &BlueprintResource{}
+
+	model := func(mutate func(*BlueprintResourceModel)) *BlueprintResourceModel {
+		m := &BlueprintResourceModel{
+			ID:          types.StringValue("013d8b7c-e12d-4086-b309-8fd99058e5b0"),
+			Name:        types.StringValue("Corp Passcode"),
+			Description: types.StringNull(),
+			DeviceGroups: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("2b1c3a2e-5d1f-4f7e-9c8b-1a2b3c4d5e6f"),
+				types.StringValue("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"),
+			}),
+			Deploy:              types.BoolValue(true),
+			WaitForDeployment:   types.BoolNull(),
+			PollInterval:        types.StringNull(),
+			StepComponentsModel: emptyStepComponents(),
+		}
+		m.LegacyPayloads = jsontypes.NewNormalizedNull()
+		if mutate != nil {
+			mutate(m)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name string
+		plan *BlueprintResourceModel
+		want bool
+	}{
+		{
+			name: "unchanged",
+			plan: model(nil),
+		},
+		{
+			name: "local settings",
+			plan: model(func(m *BlueprintResourceModel) {
+				m.WaitForDeployment = types.BoolValue(true)
+				m.PollInterval = types.StringValue("10s")
+			}),
+		},
+		{
+			name: "device group order",
+			plan: model(func(m *BlueprintResourceModel) {
+				m.DeviceGroups = types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"),
+					types.StringValue("2b1c3a2e-5d1f-4f7e-9c8b-1a2b3c4d5e6f"),
+				})
+			}),
+		},
+		{
+			name: "name",
+			plan: model(func(m *BlueprintResourceModel) { m.Name = types.StringValue("Corp Passcode v2") }),
+			want: true,
+		},
+		{
+			name: "raw component",
+			plan: model(func(m *BlueprintResourceModel) {
+				m.Components = []ComponentModel{{
+					Identifier:        types.StringValue("com.jamf.ddm.disk-management"),
+					ConfigurationJSON: jsontypes.NewNormalizedValue(`{"Restrictions":{}}`),
+				}}
+			}),
+			want: true,
+		},
+		{
+			name: "deploy",
+			plan: model(func(m *BlueprintResourceModel) { m.Deploy = types.BoolValue(false) }),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := r.blueprintSettingsChanged(ctx, tt.plan, model(nil))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("blueprintSettingsChanged() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,15 +6,19 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/jsontypes"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint/components"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -24,6 +28,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BlueprintResource{}
 var _ resource.ResourceWithImportState = &BlueprintResource{}
+var _ resource.ResourceWithValidateConfig = &BlueprintResource{}
 
 // NewBlueprintResource returns a new instance of BlueprintResource.
 func NewBlueprintResource() resource.Resource {
@@ -37,8 +42,10 @@ func (r *BlueprintResource) Metadata(ctx context.Context, req resource.MetadataR
 
 // Schema returns the Terraform schema for the blueprint resource.
 func (r *BlueprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	blocks := resourceBlocks(ctx)
+
 	resp.Schema = schema.Schema{
-		Description: "Resource schema for creating and managing Jamf Blueprints. Blueprints are automatically deployed after successful creation or update.",
+		Description: "Resource schema for creating and managing Jamf Blueprints. Blueprints are automatically deployed after successful creation or update unless deploy is set to false. Components added, removed or changed outside Terraform are detected on refresh and shown as changes in the plan.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the blueprint.",
@@ -79,109 +86,187 @@ func (r *BlueprintResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "Current deployment state.",
 				Computed:    true,
 			},
-			"legacy_payloads": schema.StringAttribute{
-				Description: "JSON-encoded array of legacy configuration profile payload objects. Refer to https://github.com/apple/device-management/tree/release/mdm/profiles for individual payload schemas. Each payload must have payloadType and payloadIdentifier fields. The payload display name will automatically use the blueprint name.",
+			"last_deployment_started": schema.StringAttribute{
+				Description: "Start timestamp of the most recent deployment.",
+				Computed:    true,
+			},
+			"last_deployment_state": schema.StringAttribute{
+				Description: "State of the most recent deployment.",
+				Computed:    true,
+			},
+			"deploy": schema.BoolAttribute{
+				Description: "Whether the blueprint is deployed after each create or update. Set to false to stage changes as a draft and deploy later, for example with the jamfplatform_blueprints_deployment resource. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Description: "When true, create and update wait for the triggered deployment to finish and fail the apply if it does not succeed. The wait is bounded by the create and update timeouts. Ignored when deploy is false. Defaults to false, so that blueprints applied without it keep returning as soon as the deployment is triggered; jamfplatform_blueprints_deployment, which only exists to deploy, waits by default.",
 				Optional:    true,
 			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often the provider polls the deployment state when wait_for_deployment is enabled, as a duration string (e.g. \"10s\"). Defaults to 5s.",
+				Optional:    true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`),
+					"must be a duration string such as 5s, 1m or 1m30s",
+				)},
+			},
+			"legacy_payloads": legacyPayloadsAttribute(),
 		},
-		Blocks: map[string]schema.Block{
-			"raw_component": schema.ListNestedBlock{
-				Description: "Raw component configuration using key-value pairs.",
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"identifier": schema.StringAttribute{
-							Description: "Component identifier (e.g., com.jamf.ddm.disk-management).",
-							Required:    true,
-						},
-						"configuration": schema.MapAttribute{
-							Description: "Component configuration as key-value pairs. Each component has its own unique configuration options.",
-							Optional:    true,
-							ElementType: types.StringType,
-						},
+		Blocks: blocks,
+	}
+}
+
+// resourceBlocks returns the top-level blocks of the blueprint resource.
+func resourceBlocks(ctx context.Context) map[string]schema.Block {
+	blocks := stepComponentBlocks()
+	blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: "Maximum time allowed for creating and deploying the blueprint. Defaults to 30m.",
+		UpdateDescription: "Maximum time allowed for updating and deploying the blueprint. Defaults to 30m.",
+		DeleteDescription: "Maximum time allowed for deleting the blueprint. Defaults to 30m.",
+	})
+	blocks["step"] = schema.ListNestedBlock{
+		Description: "Ordered list of named blueprint steps, each with its own component blocks. Use instead of top-level component blocks to build multi-step blueprints.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Step name.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
 					},
 				},
+				"legacy_payloads": legacyPayloadsAttribute(),
 			},
-			"audio_accessory_settings": schema.ListNestedBlock{
-				Description:  "Audio accessory settings component for managing temporary pairing and unpairing policies.",
-				NestedObject: components.AudioAccessorySettingsComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
+			Blocks: stepComponentBlocks(),
+		},
+	}
+	return blocks
+}
+
+// stepComponentBlocks returns the component blocks available at the top level and in each step block.
+func stepComponentBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"raw_component": schema.ListNestedBlock{
+			Description: "Raw component configuration using key-value pairs or a JSON document.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"identifier": schema.StringAttribute{
+						Description: "Component identifier (e.g., com.jamf.ddm.disk-management).",
+						Required:    true,
+					},
+					"configuration": schema.MapAttribute{
+						Description: "Component configuration as key-value pairs. Each component has its own unique configuration options. Nested keys are joined with underscores, so keys that contain underscores, numeric strings and empty strings do not round-trip; use configuration_json for those.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"configuration_json": schema.StringAttribute{
+						Description: "Component configuration as a JSON document, sent to the API as-is. Formatting and key order differences are ignored when comparing with the API. Conflicts with configuration.",
+						Optional:    true,
+						CustomType:  jsontypes.NormalizedType{},
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("configuration")),
+						},
+					},
 				},
 			},
-			"disk_management_settings": schema.ListNestedBlock{
-				Description:  "Disk management settings component for controlling external and network storage restrictions.",
-				NestedObject: components.DiskManagementPolicyComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"audio_accessory_settings": schema.ListNestedBlock{
+			Description:  "Audio accessory settings component for managing temporary pairing and unpairing policies.",
+			NestedObject: components.AudioAccessorySettingsComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"math_settings": schema.ListNestedBlock{
-				Description:  "Math settings component for managing calculator modes and system behavior.",
-				NestedObject: components.MathSettingsComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"disk_management_settings": schema.ListNestedBlock{
+			Description:  "Disk management settings component for controlling external and network storage restrictions.",
+			NestedObject: components.DiskManagementPolicyComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"passcode_policy": schema.ListNestedBlock{
-				Description:  "Passcode policy component for managing device passcode requirements and restrictions.",
-				NestedObject: components.PasscodePolicyComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"math_settings": schema.ListNestedBlock{
+			Description:  "Math settings component for managing calculator modes and system behavior.",
+			NestedObject: components.MathSettingsComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"safari_bookmarks": schema.ListNestedBlock{
-				Description:  "Safari bookmarks component for managing Safari managed bookmarks and bookmark groups.",
-				NestedObject: components.SafariBookmarksComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"passcode_policy": schema.ListNestedBlock{
+			Description:  "Passcode policy component for managing device passcode requirements and restrictions.",
+			NestedObject: components.PasscodePolicyComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"safari_extensions": schema.ListNestedBlock{
-				Description:  "Safari extensions component for managing Safari extension permissions and states.",
-				NestedObject: components.SafariExtensionsComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"safari_bookmarks": schema.ListNestedBlock{
+			Description:  "Safari bookmarks component for managing Safari managed bookmarks and bookmark groups.",
+			NestedObject: components.SafariBookmarksComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"safari_settings": schema.ListNestedBlock{
-				Description:  "Safari settings component for managing Safari browser behavior and security settings.",
-				NestedObject: components.SafariSettingsComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"safari_extensions": schema.ListNestedBlock{
+			Description:  "Safari extensions component for managing Safari extension permissions and states.",
+			NestedObject: components.SafariExtensionsComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"service_background_tasks": schema.ListNestedBlock{
-				Description:  "Service background tasks component for managing background service tasks and launchd configurations.",
-				NestedObject: components.ServiceBackgroundTasksComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"safari_settings": schema.ListNestedBlock{
+			Description:  "Safari settings component for managing Safari browser behavior and security settings.",
+			NestedObject: components.SafariSettingsComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"service_configuration_files": schema.ListNestedBlock{
-				Description:  "Service configuration files component for managing configuration files for system services.",
-				NestedObject: components.ServiceConfigurationFilesComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"service_background_tasks": schema.ListNestedBlock{
+			Description:  "Service background tasks component for managing background service tasks and launchd configurations.",
+			NestedObject: components.ServiceBackgroundTasksComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"software_update": schema.ListNestedBlock{
-				Description:  "Software update component for enforcing OS updates on devices.",
-				NestedObject: components.SoftwareUpdateComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"service_configuration_files": schema.ListNestedBlock{
+			Description:  "Service configuration files component for managing configuration files for system services.",
+			NestedObject: components.ServiceConfigurationFilesComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
-			"software_update_settings": schema.ListNestedBlock{
-				Description:  "Software update settings component for configuring system update behavior and policies.",
-				NestedObject: components.SoftwareUpdateSettingsComponentSchema(),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+		},
+		"software_update": schema.ListNestedBlock{
+			Description:  "Software update component for enforcing OS updates on devices.",
+			NestedObject: components.SoftwareUpdateComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		},
+		"software_update_settings": schema.ListNestedBlock{
+			Description:  "Software update settings component for configuring system update behavior and policies.",
+			NestedObject: components.SoftwareUpdateSettingsComponentSchema(),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
 			},
 		},
 	}
 }
 
+// legacyPayloadsAttribute returns the legacy_payloads attribute available at the top level and in each step block.
+func legacyPayloadsAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "JSON-encoded array of legacy configuration profile payload objects. Refer to https://github.com/apple/device-management/tree/release/mdm/profiles for individual payload schemas. Each payload must have payloadType and payloadIdentifier fields. The payload display name will automatically use the blueprint name. Formatting and key order differences are ignored when comparing with the API.",
+		Optional:    true,
+		CustomType:  jsontypes.NormalizedType{},
+		Validators: []validator.String{
+			legacyPayloadsValidator{},
+		},
+	}
+}
+
 // Configure sets up the API client for the resource from the provider configuration.
 func (r *BlueprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -202,7 +287,66 @@ func (r *BlueprintResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
-// ImportState handles the import of existing Blueprint resources.
+// ValidateConfig rejects configurations that mix step blocks with top-level component blocks.
+func (r *BlueprintResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("step"), &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsNull() || steps.IsUnknown() || len(steps.Elements()) == 0 {
+		return
+	}
+
+	for name := range stepComponentBlocks() {
+		var block types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &block)...)
+		if !block.IsNull() && !block.IsUnknown() && len(block.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting component configuration",
+				"Component blocks must be placed inside step blocks when step is used, not at the top level of the blueprint.",
+			)
+		}
+	}
+
+	var legacyPayloads jsontypes.Normalized
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("legacy_payloads"), &legacyPayloads)...)
+	if !legacyPayloads.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("legacy_payloads"),
+			"Conflicting component configuration",
+			"legacy_payloads must be set inside step blocks when step is used, not at the top level of the blueprint.",
+		)
+	}
+}
+
+// ImportState handles the import of existing Blueprint resources by ID or,
+// with the name: prefix, by exact blueprint name.
 func (r *BlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := req.ID
+	if name, ok := strings.CutPrefix(req.ID, importNamePrefix); ok {
+		blueprint, err := r.client.GetBlueprintByNameV1(ctx, name)
+		if err != nil {
+			switch {
+			case client.IsAmbiguous(err):
+				resp.Diagnostics.AddError(
+					"Ambiguous blueprint name",
+					"Could not import blueprint by name: "+err.Error()+". Import the blueprint by ID instead.",
+				)
+			case client.IsNotFound(err):
+				resp.Diagnostics.AddError(
+					"Blueprint not found",
+					"Could not import blueprint by name: "+err.Error(),
+				)
+			default:
+				resp.Diagnostics.AddError(
+					"Error importing blueprint",
+					"Could not look up blueprint by name: "+err.Error(),
+				)
+			}
+			return
+		}
+		id = blueprint.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deploy"), true)...)
 }
@@ -0,0 +1,109 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBlueprintResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &BlueprintResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	stepType := schemaType.AttributeTypes["step"].(tftypes.List)
+	step := nullObject(stepType.ElementType.(tftypes.Object), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "Step 1"),
+	})
+	steps := tftypes.NewValue(stepType, []tftypes.Value{step})
+
+	rawType := schemaType.AttributeTypes["raw_component"].(tftypes.List)
+	rawComponents := tftypes.NewValue(rawType, []tftypes.Value{
+		nullObject(rawType.ElementType.(tftypes.Object), map[string]tftypes.Value{
+			"identifier": tftypes.NewValue(tftypes.String, "com.jamf.ddm.disk-management"),
+		}),
+	})
+
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		wantPaths []path.Path
+	}{
+		{
+			name:   "step only",
+			values: map[string]tftypes.Value{"step": steps},
+		},
+		{
+			name: "top-level legacy_payloads",
+			values: map[string]tftypes.Value{
+				"step":            steps,
+				"legacy_payloads": tftypes.NewValue(tftypes.String, `[{"PayloadType":"com.apple.example"}]`),
+			},
+			wantPaths: []path.Path{path.Root("legacy_payloads")},
+		},
+		{
+			name: "top-level component block",
+			values: map[string]tftypes.Value{
+				"step":          steps,
+				"raw_component": rawComponents,
+			},
+			wantPaths: []path.Path{path.Root("raw_component")},
+		},
+		{
+			name:   "no step",
+			values: map[string]tftypes.Value{"raw_component": rawComponents},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    nullObject(schemaType, tt.values),
+				},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != len(tt.wantPaths) {
+				t.Fatalf("got %d errors, want %d: %v", got, len(tt.wantPaths), resp.Diagnostics)
+			}
+			for i, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok {
+					t.Fatalf("error %q has no attribute path", d.Summary())
+				}
+				if !withPath.Path().Equal(tt.wantPaths[i]) {
+					t.Errorf("error %d path = %s, want %s", i, withPath.Path(), tt.wantPaths[i])
+				}
+			}
+		})
+	}
+}
+
+// nullObject builds an object value of the given type with the given
+// attribute values and every other attribute null.
+func nullObject(typ tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(typ, attrs)
+}
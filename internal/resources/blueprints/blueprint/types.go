@@ -4,7 +4,9 @@ package blueprint
 
 import (
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/jsontypes"
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint/components"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,10 +17,33 @@ type BlueprintResource struct {
 
 // BlueprintResourceModel represents the Terraform resource model for a Jamf Blueprint.
 type BlueprintResourceModel struct {
-	ID                        types.String                                    `tfsdk:"id"`
-	Name                      types.String                                    `tfsdk:"name"`
-	Description               types.String                                    `tfsdk:"description"`
-	DeviceGroups              types.Set                                       `tfsdk:"device_groups"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	DeviceGroups types.Set    `tfsdk:"device_groups"`
+	StepComponentsModel
+	Steps                 []StepModel    `tfsdk:"step"`
+	Created               types.String   `tfsdk:"created"`
+	Updated               types.String   `tfsdk:"updated"`
+	DeploymentState       types.String   `tfsdk:"deployment_state"`
+	LastDeploymentStarted types.String   `tfsdk:"last_deployment_started"`
+	LastDeploymentState   types.String   `tfsdk:"last_deployment_state"`
+	Deploy                types.Bool     `tfsdk:"deploy"`
+	WaitForDeployment     types.Bool     `tfsdk:"wait_for_deployment"`
+	PollInterval          types.String   `tfsdk:"poll_interval"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// StepModel represents a named blueprint step and its components.
+type StepModel struct {
+	Name types.String `tfsdk:"name"`
+	StepComponentsModel
+}
+
+// StepComponentsModel holds the component blocks of a blueprint step. It is
+// embedded at the top level of the resource for single-step blueprints and in
+// each step block for multi-step blueprints.
+type StepComponentsModel struct {
 	Components                []ComponentModel                                `tfsdk:"raw_component"`
 	AudioAccessorySettings    []components.AudioAccessorySettingsComponent    `tfsdk:"audio_accessory_settings"`
 	DiskManagementSettings    []components.DiskManagementPolicyComponent      `tfsdk:"disk_management_settings"`
@@ -31,10 +56,7 @@ type BlueprintResourceModel struct {
 	ServiceConfigurationFiles []components.ServiceConfigurationFilesComponent `tfsdk:"service_configuration_files"`
 	SoftwareUpdate            []components.SoftwareUpdateComponent            `tfsdk:"software_update"`
 	SoftwareUpdateSettings    []components.SoftwareUpdateSettingsComponent    `tfsdk:"software_update_settings"`
-	LegacyPayloads            types.String                                    `tfsdk:"legacy_payloads"`
-	Created                   types.String                                    `tfsdk:"created"`
-	Updated                   types.String                                    `tfsdk:"updated"`
-	DeploymentState           types.String                                    `tfsdk:"deployment_state"`
+	LegacyPayloads            jsontypes.Normalized                            `tfsdk:"legacy_payloads"`
 }
 
 // BlueprintDataSource implements the Terraform data source for Jamf Blueprint.
@@ -57,6 +79,7 @@ type BlueprintDataSourceModel struct {
 
 // ComponentModel defines the data structure for a blueprint component.
 type ComponentModel struct {
-	Identifier    types.String `tfsdk:"identifier"`
-	Configuration types.Map    `tfsdk:"configuration"`
+	Identifier        types.String         `tfsdk:"identifier"`
+	Configuration     types.Map            `tfsdk:"configuration"`
+	ConfigurationJSON jsontypes.Normalized `tfsdk:"configuration_json"`
 }
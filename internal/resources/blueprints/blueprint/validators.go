@@ -0,0 +1,67 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// legacyPayloadsValidator checks that legacy_payloads is a JSON array of
+// payload objects that each carry payloadType and payloadIdentifier.
+type legacyPayloadsValidator struct{}
+
+var _ validator.String = legacyPayloadsValidator{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v legacyPayloadsValidator) Description(_ context.Context) string {
+	return "value must be a JSON array of payload objects, each with payloadType and payloadIdentifier"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v legacyPayloadsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v legacyPayloadsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var payloads []interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &payloads); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid legacy payloads",
+			"legacy_payloads must be a JSON array of payload objects: "+err.Error(),
+		)
+		return
+	}
+
+	for i, payload := range payloads {
+		payloadObj, ok := payload.(map[string]interface{})
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid legacy payload",
+				fmt.Sprintf("Payload at index %d (%s[%d]) must be a JSON object.", i, req.Path, i),
+			)
+			continue
+		}
+
+		for _, field := range []string{"payloadType", "payloadIdentifier"} {
+			value, ok := payloadObj[field].(string)
+			if !ok || value == "" {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid legacy payload",
+					fmt.Sprintf("Payload at index %d (%s[%d].%s) must have a non-empty string %s field.", i, req.Path, i, field, field),
+				)
+			}
+		}
+	}
+}
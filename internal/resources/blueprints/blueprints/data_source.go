@@ -0,0 +1,201 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprints
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &BlueprintsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &BlueprintsDataSource{}
+)
+
+// NewBlueprintsDataSource returns a new instance of BlueprintsDataSource.
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+// Metadata sets the data source type name for the Terraform provider.
+func (d *BlueprintsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints_blueprints"
+}
+
+// Schema sets the Terraform schema for the data source.
+func (d *BlueprintsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all blueprints, optionally searched, sorted and filtered.",
+		Attributes: map[string]schema.Attribute{
+			"sort": schema.ListAttribute{
+				Description: "Sort criteria passed to the API, in the form property:direction (e.g., 'name:asc').",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Search string passed to the API to limit results.",
+				Optional:    true,
+			},
+			"deployment_state": schema.StringAttribute{
+				Description: "Only return blueprints in this deployment state (case-insensitive, e.g., 'DEPLOYED').",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return blueprints whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"blueprints": schema.ListNestedAttribute{
+				Description: "List of matching blueprints.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Blueprint ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Blueprint name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description.",
+							Computed:    true,
+						},
+						"created": schema.StringAttribute{
+							Description: "Created at (RFC3339).",
+							Computed:    true,
+						},
+						"updated": schema.StringAttribute{
+							Description: "Updated at (RFC3339).",
+							Computed:    true,
+						},
+						"deployment_state": schema.StringAttribute{
+							Description: "Deployment state.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that name_regex is a valid regular expression.
+func (d *BlueprintsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid regular expression",
+			err.Error(),
+		)
+	}
+}
+
+// Configure sets up the API client for the data source from the provider configuration.
+func (d *BlueprintsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches all blueprints, applies the filters and populates the Terraform state.
+func (d *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider client was not configured. Please ensure provider block is set up correctly.",
+		)
+		return
+	}
+
+	var sort []string
+	resp.Diagnostics.Append(data.Sort.ElementsAs(ctx, &sort, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	blueprints, err := d.client.GetBlueprintsV1(ctx, sort, data.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get blueprints",
+			err.Error(),
+		)
+		return
+	}
+
+	blueprintsList := []BlueprintListModel{}
+	for _, bp := range blueprints {
+		if !data.DeploymentState.IsNull() && !strings.EqualFold(bp.DeploymentState.State, data.DeploymentState.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(bp.Name) {
+			continue
+		}
+
+		blueprintsList = append(blueprintsList, BlueprintListModel{
+			ID:              types.StringValue(bp.ID),
+			Name:            types.StringValue(bp.Name),
+			Description:     types.StringValue(bp.Description),
+			Created:         types.StringValue(bp.Created),
+			Updated:         types.StringValue(bp.Updated),
+			DeploymentState: types.StringValue(bp.DeploymentState.State),
+		})
+	}
+
+	data.Blueprints = blueprintsList
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 Jamf Software LLC.
+
+package blueprints
+
+import (
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BlueprintsDataSource defines the data source for listing blueprints.
+type BlueprintsDataSource struct {
+	client *client.Client
+}
+
+// BlueprintsDataSourceModel defines the data structure for the blueprints data source.
+type BlueprintsDataSourceModel struct {
+	Sort            types.List           `tfsdk:"sort"`
+	Search          types.String         `tfsdk:"search"`
+	DeploymentState types.String         `tfsdk:"deployment_state"`
+	NameRegex       types.String         `tfsdk:"name_regex"`
+	Blueprints      []BlueprintListModel `tfsdk:"blueprints"`
+}
+
+// BlueprintListModel defines the data structure for a blueprint in the list.
+type BlueprintListModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Created         types.String `tfsdk:"created"`
+	Updated         types.String `tfsdk:"updated"`
+	DeploymentState types.String `tfsdk:"deployment_state"`
+}
@@ -107,6 +107,13 @@ func (d *ComponentDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	comp, err := d.client.GetBlueprintComponentByIDV1(ctx, data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Component not found",
+				fmt.Sprintf("No blueprint component with identifier %s exists.", data.ID.ValueString()),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to get component",
 			err.Error(),
@@ -0,0 +1,154 @@
+// Copyright 2025 Jamf Software LLC.
+
+package deployment
+
+import (
+	"context"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/poll"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/resources/blueprints/blueprint"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Create deploys the blueprint and records the resulting deployment.
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	blueprintID := data.BlueprintID.ValueString()
+
+	current, err := r.client.GetBlueprintByIDV1(ctx, blueprintID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("blueprint_id"), "Blueprint not found", "No blueprint exists with ID "+blueprintID+".")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading blueprint",
+			"Could not read blueprint before deploying: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.DeployBlueprintV1(ctx, blueprintID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deploying blueprint",
+			"Could not deploy blueprint: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Blueprint deployment triggered", map[string]interface{}{
+		"blueprint_id": blueprintID,
+	})
+
+	var deployed *client.BlueprintDetailV1
+	if data.WaitForDeployment.ValueBool() {
+		deployed, err = blueprint.WaitForBlueprintDeployment(ctx, r.client, blueprintID, lastDeploymentStarted(current), interval)
+		if err != nil {
+			resp.Diagnostics.AddError("Blueprint deployment failed", err.Error())
+		}
+	}
+
+	if deployed == nil {
+		deployed, err = r.client.GetBlueprintByIDV1(ctx, blueprintID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading deployed blueprint",
+				"Could not read blueprint after deploying: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	updateModelFromDeployment(&data, deployed)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// Read verifies that the deployed blueprint still exists. The recorded
+// deployment is kept as-is so later deployments do not show up as drift;
+// imported deployments record the most recent deployment of the blueprint.
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetBlueprintByIDV1(ctx, data.BlueprintID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Info(ctx, "Blueprint not found, removing deployment from state", map[string]interface{}{
+				"blueprint_id": data.BlueprintID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading blueprint",
+			"Could not read blueprint: "+err.Error(),
+		)
+		return
+	}
+
+	if data.DeploymentState.IsNull() {
+		updateModelFromDeployment(&data, current)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// Update stores changes to settings that do not trigger a redeployment.
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// Delete removes the deployment from state. Blueprints cannot be undeployed,
+// so the blueprint itself is left untouched.
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing blueprint deployment from state", map[string]interface{}{
+		"blueprint_id": data.BlueprintID.ValueString(),
+	})
+}
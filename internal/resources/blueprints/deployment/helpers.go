@@ -0,0 +1,33 @@
+// Copyright 2025 Jamf Software LLC.
+
+package deployment
+
+import (
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default timeout used when not configured.
+const defaultCreateTimeout = 30 * time.Minute
+
+// updateModelFromDeployment records the most recent deployment of the blueprint in the model.
+func updateModelFromDeployment(model *DeploymentResourceModel, blueprint *client.BlueprintDetailV1) {
+	model.ID = types.StringValue(blueprint.ID)
+	if last := blueprint.DeploymentState.LastDeployment; last != nil {
+		model.DeploymentStarted = types.StringValue(last.Started)
+		model.DeploymentState = types.StringValue(last.State)
+	} else {
+		model.DeploymentStarted = types.StringNull()
+		model.DeploymentState = types.StringValue(blueprint.DeploymentState.State)
+	}
+}
+
+// lastDeploymentStarted returns the start time of the most recent deployment, or an empty string.
+func lastDeploymentStarted(blueprint *client.BlueprintDetailV1) string {
+	if blueprint.DeploymentState.LastDeployment == nil {
+		return ""
+	}
+	return blueprint.DeploymentState.LastDeployment.Started
+}
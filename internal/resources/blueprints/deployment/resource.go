@@ -0,0 +1,134 @@
+// Copyright 2025 Jamf Software LLC.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeploymentResource{}
+var _ resource.ResourceWithImportState = &DeploymentResource{}
+
+// NewDeploymentResource returns a new instance of DeploymentResource.
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+// Metadata sets the resource type name for the Terraform provider.
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints_deployment"
+}
+
+// Schema returns the Terraform schema for the blueprint deployment resource.
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deploys a Jamf Blueprint. A deployment is triggered when the resource is created and again whenever blueprint_id or triggers change. Destroying the resource does not undeploy the blueprint. Use together with deploy = false on jamfplatform_blueprints_blueprint to stage changes and deploy on demand.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the deployment resource (the blueprint ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"blueprint_id": schema.StringAttribute{
+				Description: "ID of the blueprint to deploy. Changing this triggers a new deployment.",
+				Required:    true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
+					"must be a valid UUID",
+				)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, trigger a redeployment of the blueprint.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Description: "When true, wait for the deployment to finish and fail the apply if it does not succeed. The wait is bounded by the create timeout. Defaults to true because deploying is the only purpose of this resource, unlike wait_for_deployment on jamfplatform_blueprints_blueprint, which defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often the provider polls the deployment state while waiting, as a duration string (e.g. \"10s\"). Defaults to 5s.",
+				Optional:    true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`),
+					"must be a duration string such as 5s, 1m or 1m30s",
+				)},
+			},
+			"deployment_started": schema.StringAttribute{
+				Description: "Start timestamp of the deployment triggered by this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_state": schema.StringAttribute{
+				Description: "Final state of the deployment triggered by this resource, or the last observed state when wait_for_deployment is false.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Maximum time allowed for deploying the blueprint and waiting for the deployment to finish. Defaults to 30m.",
+			}),
+		},
+	}
+}
+
+// Configure sets up the API client for the resource from the provider configuration.
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the deployment of a blueprint by blueprint ID. The most
+// recent deployment of the blueprint is recorded by the following Read; no new
+// deployment is triggered.
+func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_deployment"), true)...)
+}
@@ -0,0 +1,26 @@
+// Copyright 2025 Jamf Software LLC.
+
+package deployment
+
+import (
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeploymentResource implements the Terraform resource for deploying a Jamf Blueprint.
+type DeploymentResource struct {
+	client *client.Client
+}
+
+// DeploymentResourceModel represents the Terraform resource model for a blueprint deployment.
+type DeploymentResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	BlueprintID       types.String   `tfsdk:"blueprint_id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForDeployment types.Bool     `tfsdk:"wait_for_deployment"`
+	PollInterval      types.String   `tfsdk:"poll_interval"`
+	DeploymentStarted types.String   `tfsdk:"deployment_started"`
+	DeploymentState   types.String   `tfsdk:"deployment_state"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
@@ -5,10 +5,11 @@ package benchmark
 import (
 	"context"
 	"fmt"
-	"time"
+	"slices"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/poll"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,34 +24,30 @@ func (r *BenchmarkResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	reqBody := &client.CBEngineBenchmarkRequestV2{
-		Title:            data.Title.ValueString(),
-		Description:      data.Description.ValueString(),
-		SourceBaselineID: data.SourceBaselineID.ValueString(),
-		Sources:          make([]client.CBEngineSourceV1, len(data.Sources)),
-		Rules:            make([]client.CBEngineRuleRequestV2, len(data.Rules)),
-		Target: client.CBEngineTargetV2{
-			DeviceGroups: []string{data.TargetDeviceGroup.ValueString()},
-		},
-		EnforcementMode: data.EnforcementMode.ValueString(),
-	}
-	for i, s := range data.Sources {
-		reqBody.Sources[i] = client.CBEngineSourceV1{
-			Branch:   s.Branch.ValueString(),
-			Revision: s.Revision.ValueString(),
-		}
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
 	}
-	for i, rule := range data.Rules {
-		rr := client.CBEngineRuleRequestV2{
-			ID:      rule.ID.ValueString(),
-			Enabled: rule.Enabled.ValueBool(),
-		}
-		if !rule.ODVValue.IsNull() && rule.ODVValue.ValueString() != "" {
-			rr.ODV = &client.CBEngineODVRequestV2{
-				Value: rule.ODVValue.ValueString(),
-			}
-		}
-		reqBody.Rules[i] = rr
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if data.EffectiveRules.IsUnknown() {
+		resp.Diagnostics.Append(r.resolveEffectiveRules(ctx, &data)...)
+	}
+	reqBody, diags := benchmarkRequestFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if data.TargetDeviceGroups.IsUnknown() && reqBody != nil {
+		data.TargetDeviceGroups, diags = types.SetValueFrom(ctx, types.StringType, reqBody.Target.DeviceGroups)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Debug(ctx, "creating cbengine benchmark", map[string]interface{}{
@@ -68,185 +65,30 @@ func (r *BenchmarkResource) Create(ctx context.Context, req resource.CreateReque
 		"tenant_id":    bench.TenantID,
 	})
 
-	pollInterval := 5 * time.Second
+	data.ID = types.StringValue(bench.BenchmarkID)
+	data.TenantID = types.StringValue(bench.TenantID)
+	data.Deleted = types.BoolValue(bench.Deleted)
+	data.UpdateAvailable = types.BoolValue(bench.UpdateAvailable)
+	data.LastUpdatedAt = types.StringValue(bench.LastUpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	applyRuleMetadata(data.Rules, bench.Rules)
+
 	tflog.Debug(ctx, "waiting for benchmark to reach SYNCED state", map[string]interface{}{
 		"benchmark_id":  bench.BenchmarkID,
-		"poll_interval": pollInterval.String(),
+		"poll_interval": interval.String(),
+		"timeout":       createTimeout.String(),
 	})
 
-	syncedBench, err := waitForBenchmarkSync(ctx, r.client, bench.BenchmarkID, pollInterval)
-	if err != nil {
+	if _, err := waitForBenchmarkSync(ctx, r.client, bench.BenchmarkID, interval); err != nil {
 		tflog.Error(ctx, "wait for benchmark sync failed", map[string]interface{}{"error": err.Error(), "benchmark_id": bench.BenchmarkID})
 		resp.Diagnostics.AddError("Error waiting for benchmark to sync", err.Error())
+		// Record the created benchmark so Terraform marks it as tainted
+		// instead of losing track of it.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	tflog.Debug(ctx, "benchmark synced", map[string]interface{}{"benchmark_id": syncedBench.ID})
-
-	data.ID = types.StringValue(syncedBench.ID)
-	data.TenantID = types.StringValue(bench.TenantID)
-	data.Deleted = types.BoolValue(bench.Deleted)
-	data.UpdateAvailable = types.BoolValue(bench.UpdateAvailable)
-	data.LastUpdatedAt = types.StringValue(bench.LastUpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
-
-	for i, r := range bench.Rules {
-		if i >= len(data.Rules) {
-			break
-		}
-
-		var references types.List
-		if len(r.References) == 0 {
-			references = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.References))
-			for j, ref := range r.References {
-				vals[j] = types.StringValue(ref)
-			}
-			references, _ = types.ListValue(types.StringType, vals)
-		}
-
-		var supportedOS types.List
-		osInfoObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"os_type":         types.StringType,
-				"os_version":      types.Int64Type,
-				"management_type": types.StringType,
-			},
-		}
-		if len(r.SupportedOS) == 0 {
-			supportedOS = types.ListNull(osInfoObjType)
-		} else {
-			osVals := make([]attr.Value, len(r.SupportedOS))
-			for j, os := range r.SupportedOS {
-				osVals[j], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"os_type":         types.StringType,
-						"os_version":      types.Int64Type,
-						"management_type": types.StringType,
-					},
-					map[string]attr.Value{
-						"os_type":         types.StringValue(os.OSType),
-						"os_version":      types.Int64Value(int64(os.OSVersion)),
-						"management_type": types.StringValue(os.ManagementType),
-					},
-				)
-			}
-			supportedOS, _ = types.ListValue(osInfoObjType, osVals)
-		}
-
-		osSpecObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"title":       types.StringType,
-				"description": types.StringType,
-				"odv_value":   types.StringType,
-				"odv_hint":    types.StringType,
-			},
-		}
-		var osSpecificDefaults types.Map
-		if len(r.OSSpecificDefaults) == 0 {
-			osSpecificDefaults = types.MapNull(osSpecObjType)
-		} else {
-			vals := make(map[string]attr.Value, len(r.OSSpecificDefaults))
-			for k, v := range r.OSSpecificDefaults {
-				var odvValue, odvHint types.String
-				if v.ODV != nil {
-					odvValue = types.StringValue(v.ODV.Value)
-					odvHint = types.StringValue(v.ODV.Hint)
-				} else {
-					odvValue = types.StringNull()
-					odvHint = types.StringNull()
-				}
-				vals[k], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"title":       types.StringType,
-						"description": types.StringType,
-						"odv_value":   types.StringType,
-						"odv_hint":    types.StringType,
-					},
-					map[string]attr.Value{
-						"title":       types.StringValue(v.Title),
-						"description": types.StringValue(v.Description),
-						"odv_value":   odvValue,
-						"odv_hint":    odvHint,
-					},
-				)
-			}
-			osSpecificDefaults, _ = types.MapValue(osSpecObjType, vals)
-		}
-
-		var odvValue, odvHint, odvPlaceholder, odvType, odvValidationRegex types.String
-		var odvValidationMin, odvValidationMax types.Int64
-		var odvValidationEnumValues types.List
-		if r.ODV != nil {
-			odvValue = types.StringValue(r.ODV.Value)
-			odvHint = types.StringValue(r.ODV.Hint)
-			odvPlaceholder = types.StringValue(r.ODV.Placeholder)
-			odvType = types.StringValue(r.ODV.Type)
-			if r.ODV.Validation != nil {
-				if r.ODV.Validation.Min != nil {
-					odvValidationMin = types.Int64Value(int64(*r.ODV.Validation.Min))
-				} else {
-					odvValidationMin = types.Int64Null()
-				}
-				if r.ODV.Validation.Max != nil {
-					odvValidationMax = types.Int64Value(int64(*r.ODV.Validation.Max))
-				} else {
-					odvValidationMax = types.Int64Null()
-				}
-				enumValues := make([]attr.Value, len(r.ODV.Validation.EnumValues))
-				for k, v := range r.ODV.Validation.EnumValues {
-					enumValues[k] = types.StringValue(v)
-				}
-				if len(enumValues) == 0 {
-					odvValidationEnumValues = types.ListNull(types.StringType)
-				} else {
-					odvValidationEnumValues, _ = types.ListValue(types.StringType, enumValues)
-				}
-				odvValidationRegex = types.StringValue(r.ODV.Validation.Regex)
-			} else {
-				odvValidationMin = types.Int64Null()
-				odvValidationMax = types.Int64Null()
-				odvValidationEnumValues = types.ListNull(types.StringType)
-				odvValidationRegex = types.StringNull()
-			}
-		} else {
-			odvValue = types.StringNull()
-			odvHint = types.StringNull()
-			odvPlaceholder = types.StringNull()
-			odvType = types.StringNull()
-			odvValidationMin = types.Int64Null()
-			odvValidationMax = types.Int64Null()
-			odvValidationEnumValues = types.ListNull(types.StringType)
-			odvValidationRegex = types.StringNull()
-		}
-
-		var dependsOn types.List
-		if r.RuleRelation == nil || len(r.RuleRelation.DependsOn) == 0 {
-			dependsOn = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.RuleRelation.DependsOn))
-			for j, dep := range r.RuleRelation.DependsOn {
-				vals[j] = types.StringValue(dep)
-			}
-			dependsOn, _ = types.ListValue(types.StringType, vals)
-		}
-
-		data.Rules[i].SectionName = types.StringValue(r.SectionName)
-		data.Rules[i].Title = types.StringValue(r.Title)
-		data.Rules[i].References = references
-		data.Rules[i].Description = types.StringValue(r.Description)
-		data.Rules[i].SupportedOS = supportedOS
-		data.Rules[i].OSSpecificDefaults = osSpecificDefaults
-		data.Rules[i].ODVValue = odvValue
-		data.Rules[i].ODVHint = odvHint
-		data.Rules[i].ODVPlaceholder = odvPlaceholder
-		data.Rules[i].ODVType = odvType
-		data.Rules[i].ODVValidationMin = odvValidationMin
-		data.Rules[i].ODVValidationMax = odvValidationMax
-		data.Rules[i].ODVValidationEnumValues = odvValidationEnumValues
-		data.Rules[i].ODVValidationRegex = odvValidationRegex
-		data.Rules[i].DependsOn = dependsOn
-	}
+	tflog.Debug(ctx, "benchmark synced", map[string]interface{}{"benchmark_id": bench.BenchmarkID})
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -269,7 +111,7 @@ func (r *BenchmarkResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	bench, err := r.client.GetCBEngineBenchmarkByIDV2(ctx, data.ID.ValueString())
 	if err != nil {
-		if isNotFoundError(err) {
+		if client.IsNotFound(err) {
 			tflog.Info(ctx, "Benchmark not found, removing from state", map[string]interface{}{
 				"benchmark_id": data.ID.ValueString(),
 			})
@@ -282,7 +124,13 @@ func (r *BenchmarkResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	data.Title = types.StringValue(bench.Title)
-	data.Description = types.StringValue(bench.Description)
+	// The API returns an empty description when none was set; keep it null so
+	// that an omitted description does not show as a change.
+	if data.Description.IsNull() && bench.Description == "" {
+		data.Description = types.StringNull()
+	} else {
+		data.Description = types.StringValue(bench.Description)
+	}
 	data.TenantID = types.StringValue(bench.TenantID)
 	data.Deleted = types.BoolValue(bench.Deleted)
 	data.UpdateAvailable = types.BoolValue(bench.UpdateAvailable)
@@ -296,179 +144,169 @@ func (r *BenchmarkResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
-	data.Rules = make([]RuleModel, len(bench.Rules))
-	for i, r := range bench.Rules {
-		var references types.List
-		if len(r.References) == 0 {
-			references = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.References))
-			for j, ref := range r.References {
-				vals[j] = types.StringValue(ref)
+	// With rule_selection the rules are only reflected in effective_rules.
+	// Rules enabled by resolve_dependencies keep their configured entry, if
+	// any, so that they do not show as drift in rules.
+	if data.RuleSelection == nil {
+		var dependencyRules []string
+		if !data.DependencyRules.IsNull() && !data.DependencyRules.IsUnknown() {
+			resp.Diagnostics.Append(data.DependencyRules.ElementsAs(ctx, &dependencyRules, false)...)
+		}
+		dependencies := stringSet(dependencyRules)
+
+		prior := data.Rules
+		data.Rules = make(map[string]RuleModel, len(bench.Rules))
+		for _, r := range bench.Rules {
+			rule := ruleModelFromAPI(r)
+			if dependencies[r.ID] {
+				priorRule, ok := prior[r.ID]
+				if !ok {
+					continue
+				}
+				rule.Enabled = priorRule.Enabled
 			}
-			references, _ = types.ListValue(types.StringType, vals)
+			data.Rules[r.ID] = rule
 		}
+	}
 
-		var supportedOS types.List
-		osInfoObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"os_type":         types.StringType,
-				"os_version":      types.Int64Type,
-				"management_type": types.StringType,
-			},
-		}
-		if len(r.SupportedOS) == 0 {
-			supportedOS = types.ListNull(osInfoObjType)
+	effectiveRules, diags := effectiveRulesFromAPI(bench.Rules)
+	resp.Diagnostics.Append(diags...)
+	data.EffectiveRules = effectiveRules
+
+	data.TargetDeviceGroups, diags = types.SetValueFrom(ctx, types.StringType, bench.Target.DeviceGroups)
+	resp.Diagnostics.Append(diags...)
+
+	// The deprecated target_device_group is only kept while it is in use, and
+	// follows the targeted groups when it no longer matches one of them.
+	if !data.TargetDeviceGroup.IsNull() && !slices.Contains(bench.Target.DeviceGroups, data.TargetDeviceGroup.ValueString()) {
+		if len(bench.Target.DeviceGroups) > 0 {
+			data.TargetDeviceGroup = types.StringValue(bench.Target.DeviceGroups[0])
 		} else {
-			osVals := make([]attr.Value, len(r.SupportedOS))
-			for j, os := range r.SupportedOS {
-				osVals[j], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"os_type":         types.StringType,
-						"os_version":      types.Int64Type,
-						"management_type": types.StringType,
-					},
-					map[string]attr.Value{
-						"os_type":         types.StringValue(os.OSType),
-						"os_version":      types.Int64Value(int64(os.OSVersion)),
-						"management_type": types.StringValue(os.ManagementType),
-					},
-				)
-			}
-			supportedOS, _ = types.ListValue(osInfoObjType, osVals)
+			data.TargetDeviceGroup = types.StringNull()
 		}
+	}
+	data.EnforcementMode = types.StringValue(bench.EnforcementMode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies in-place changes to a Jamf Compliance Benchmark and waits for the
+// benchmark to sync again. When auto_accept_baseline_updates is enabled, a pending
+// baseline update is accepted first. The benchmark is only updated when the
+// request sent to the API changes.
+func (r *BenchmarkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BenchmarkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		osSpecObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"title":       types.StringType,
-				"description": types.StringType,
-				"odv_value":   types.StringType,
-				"odv_hint":    types.StringType,
-			},
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := state.ID.ValueString()
+	acceptUpdate := data.AutoAcceptUpdates.ValueBool() && state.UpdateAvailable.ValueBool()
+	if data.EffectiveRules.IsUnknown() {
+		resp.Diagnostics.Append(r.resolveEffectiveRules(ctx, &data)...)
+	}
+	reqBody, diags := benchmarkRequestFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if data.TargetDeviceGroups.IsUnknown() && reqBody != nil {
+		data.TargetDeviceGroups, diags = types.SetValueFrom(ctx, types.StringType, reqBody.Target.DeviceGroups)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if acceptUpdate {
+		tflog.Debug(ctx, "accepting baseline update for cbengine benchmark", map[string]interface{}{
+			"benchmark_id": id,
+		})
+
+		if err := r.client.AcceptCBEngineBenchmarkUpdateV2(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Error accepting benchmark baseline update", err.Error())
+			return
 		}
-		var osSpecificDefaults types.Map
-		if len(r.OSSpecificDefaults) == 0 {
-			osSpecificDefaults = types.MapNull(osSpecObjType)
-		} else {
-			vals := make(map[string]attr.Value, len(r.OSSpecificDefaults))
-			for k, v := range r.OSSpecificDefaults {
-				var odvValue, odvHint types.String
-				if v.ODV != nil {
-					odvValue = types.StringValue(v.ODV.Value)
-					odvHint = types.StringValue(v.ODV.Hint)
-				} else {
-					odvValue = types.StringNull()
-					odvHint = types.StringNull()
-				}
-				vals[k], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"title":       types.StringType,
-						"description": types.StringType,
-						"odv_value":   types.StringType,
-						"odv_hint":    types.StringType,
-					},
-					map[string]attr.Value{
-						"title":       types.StringValue(v.Title),
-						"description": types.StringValue(v.Description),
-						"odv_value":   odvValue,
-						"odv_hint":    odvHint,
-					},
-				)
-			}
-			osSpecificDefaults, _ = types.MapValue(osSpecObjType, vals)
+
+		if _, err := waitForBenchmarkSync(ctx, r.client, id, interval); err != nil {
+			tflog.Error(ctx, "wait for benchmark sync failed", map[string]interface{}{"error": err.Error(), "benchmark_id": id})
+			resp.Diagnostics.AddError("Error waiting for benchmark to sync after accepting update", err.Error())
+			return
 		}
+	}
 
-		var odvValue, odvHint, odvPlaceholder, odvType, odvValidationRegex types.String
-		var odvValidationMin, odvValidationMax types.Int64
-		var odvValidationEnumValues types.List
-		if r.ODV != nil {
-			odvValue = types.StringValue(r.ODV.Value)
-			odvHint = types.StringValue(r.ODV.Hint)
-			odvPlaceholder = types.StringValue(r.ODV.Placeholder)
-			odvType = types.StringValue(r.ODV.Type)
-			if r.ODV.Validation != nil {
-				if r.ODV.Validation.Min != nil {
-					odvValidationMin = types.Int64Value(int64(*r.ODV.Validation.Min))
-				} else {
-					odvValidationMin = types.Int64Null()
-				}
-				if r.ODV.Validation.Max != nil {
-					odvValidationMax = types.Int64Value(int64(*r.ODV.Validation.Max))
-				} else {
-					odvValidationMax = types.Int64Null()
-				}
-				enumValues := make([]attr.Value, len(r.ODV.Validation.EnumValues))
-				for k, v := range r.ODV.Validation.EnumValues {
-					enumValues[k] = types.StringValue(v)
-				}
-				if len(enumValues) == 0 {
-					odvValidationEnumValues = types.ListNull(types.StringType)
-				} else {
-					odvValidationEnumValues, _ = types.ListValue(types.StringType, enumValues)
-				}
-				odvValidationRegex = types.StringValue(r.ODV.Validation.Regex)
-			} else {
-				odvValidationMin = types.Int64Null()
-				odvValidationMax = types.Int64Null()
-				odvValidationEnumValues = types.ListNull(types.StringType)
-				odvValidationRegex = types.StringNull()
+	// Settings such as poll_interval, timeouts and auto_accept_baseline_updates
+	// are not sent to the API, so changing only those does not update the
+	// benchmark. With auto_accept_baseline_updates the sources follow the
+	// accepted baseline updates and are not compared.
+	changed, diags := benchmarkSettingsChanged(ctx, &data, &state, !data.AutoAcceptUpdates.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if changed {
+		if data.AutoAcceptUpdates.ValueBool() {
+			current, err := r.client.GetCBEngineBenchmarkByIDV2(ctx, id)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading benchmark", err.Error())
+				return
 			}
-		} else {
-			odvValue = types.StringNull()
-			odvHint = types.StringNull()
-			odvPlaceholder = types.StringNull()
-			odvType = types.StringNull()
-			odvValidationMin = types.Int64Null()
-			odvValidationMax = types.Int64Null()
-			odvValidationEnumValues = types.ListNull(types.StringType)
-			odvValidationRegex = types.StringNull()
+			reqBody.Sources = current.Sources
 		}
 
-		var dependsOn types.List
-		if r.RuleRelation == nil || len(r.RuleRelation.DependsOn) == 0 {
-			dependsOn = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.RuleRelation.DependsOn))
-			for j, dep := range r.RuleRelation.DependsOn {
-				vals[j] = types.StringValue(dep)
-			}
-			dependsOn, _ = types.ListValue(types.StringType, vals)
+		tflog.Debug(ctx, "updating cbengine benchmark", map[string]interface{}{
+			"benchmark_id": id,
+			"title":        data.Title.ValueString(),
+		})
+
+		if _, err := r.client.UpdateCBEngineBenchmarkV2(ctx, id, reqBody); err != nil {
+			resp.Diagnostics.AddError("Error updating benchmark", err.Error())
+			return
 		}
 
-		data.Rules[i] = RuleModel{
-			ID:                      types.StringValue(r.ID),
-			Enabled:                 types.BoolValue(r.Enabled),
-			SectionName:             types.StringValue(r.SectionName),
-			Title:                   types.StringValue(r.Title),
-			References:              references,
-			Description:             types.StringValue(r.Description),
-			SupportedOS:             supportedOS,
-			OSSpecificDefaults:      osSpecificDefaults,
-			ODVValue:                odvValue,
-			ODVHint:                 odvHint,
-			ODVPlaceholder:          odvPlaceholder,
-			ODVType:                 odvType,
-			ODVValidationMin:        odvValidationMin,
-			ODVValidationMax:        odvValidationMax,
-			ODVValidationEnumValues: odvValidationEnumValues,
-			ODVValidationRegex:      odvValidationRegex,
-			DependsOn:               dependsOn,
+		tflog.Debug(ctx, "waiting for benchmark to reach SYNCED state", map[string]interface{}{
+			"benchmark_id":  id,
+			"poll_interval": interval.String(),
+		})
+
+		if _, err := waitForBenchmarkSync(ctx, r.client, id, interval); err != nil {
+			tflog.Error(ctx, "wait for benchmark sync failed", map[string]interface{}{"error": err.Error(), "benchmark_id": id})
+			resp.Diagnostics.AddError("Error waiting for benchmark to sync", err.Error())
+			return
 		}
 	}
 
-	if len(bench.Target.DeviceGroups) > 0 {
-		data.TargetDeviceGroup = types.StringValue(bench.Target.DeviceGroups[0])
-	} else {
-		data.TargetDeviceGroup = types.StringNull()
+	bench, err := r.client.GetCBEngineBenchmarkByIDV2(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading updated benchmark", err.Error())
+		return
 	}
-	data.EnforcementMode = types.StringValue(bench.EnforcementMode)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	data.ID = types.StringValue(id)
+	data.TenantID = types.StringValue(bench.TenantID)
+	data.Deleted = types.BoolValue(bench.Deleted)
+	if data.UpdateAvailable.IsUnknown() {
+		data.UpdateAvailable = types.BoolValue(bench.UpdateAvailable)
+	}
+	data.LastUpdatedAt = types.StringValue(bench.LastUpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
 
-// Update is not supported for Jamf Compliance Benchmark resources. The resource must be recreated to apply changes.
-func (r *BenchmarkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Not Supported", "This resource must be destroyed and recreated to apply changes.")
+	applyRuleMetadata(data.Rules, bench.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete deletes a Jamf Compliance Benchmark resource from the API and removes it from the Terraform state.
@@ -485,9 +323,22 @@ func (r *BenchmarkResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteCBEngineBenchmarkV1(ctx, data.ID.ValueString())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	interval, err := poll.Interval(data.PollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err = r.client.DeleteCBEngineBenchmarkV1(ctx, data.ID.ValueString())
 	if err != nil {
-		if isNotFoundError(err) {
+		if client.IsNotFound(err) {
 			tflog.Info(ctx, "Benchmark already deleted", map[string]interface{}{
 				"benchmark_id": data.ID.ValueString(),
 			})
@@ -501,9 +352,8 @@ func (r *BenchmarkResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	pollInterval := 5 * time.Second
-	if err := waitForBenchmarkDeletion(ctx, r.client, data.ID.ValueString(), pollInterval); err != nil {
-		if isNotFoundError(err) {
+	if err := waitForBenchmarkDeletion(ctx, r.client, data.ID.ValueString(), interval); err != nil {
+		if client.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError(
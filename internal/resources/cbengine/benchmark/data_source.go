@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -184,10 +183,16 @@ func (d *BenchmarkDataSource) Schema(ctx context.Context, req datasource.SchemaR
 					},
 				},
 			},
-			"target_device_group": schema.StringAttribute{
-				Description: "Device group for the target configuration.",
+			"target_device_groups": schema.ListAttribute{
+				Description: "Device groups targeted by the benchmark.",
+				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"target_device_group": schema.StringAttribute{
+				Description:        "First device group targeted by the benchmark. Deprecated: use target_device_groups instead.",
+				DeprecationMessage: "Use target_device_groups instead. The target_device_group attribute will be removed in a future release.",
+				Computed:           true,
+			},
 			"enforcement_mode": schema.StringAttribute{
 				Description: "Enforcement mode.",
 				Computed:    true,
@@ -200,6 +205,10 @@ func (d *BenchmarkDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Description: "Update available flag.",
 				Computed:    true,
 			},
+			"sync_state": schema.StringAttribute{
+				Description: "Sync state (e.g. PENDING, SYNCED, FAILED).",
+				Computed:    true,
+			},
 			"last_updated_at": schema.StringAttribute{
 				Description: "Last updated at (RFC3339).",
 				Computed:    true,
@@ -259,6 +268,13 @@ func (d *BenchmarkDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Benchmark not found",
+				err.Error(),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to get benchmark",
 			err.Error(),
@@ -266,6 +282,23 @@ func (d *BenchmarkDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	// The benchmark details do not include the sync state, only the benchmark list does.
+	benchmarks, err := d.client.GetCBEngineBenchmarksV2(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get benchmarks",
+			err.Error(),
+		)
+		return
+	}
+	syncState := types.StringNull()
+	for _, b := range benchmarks.Benchmarks {
+		if b.ID == bench.BenchmarkID {
+			syncState = types.StringValue(b.SyncState)
+			break
+		}
+	}
+
 	sources := make([]SourceModel, 0, len(bench.Sources))
 	for _, s := range bench.Sources {
 		sources = append(sources, SourceModel{
@@ -274,166 +307,20 @@ func (d *BenchmarkDataSource) Read(ctx context.Context, req datasource.ReadReque
 		})
 	}
 
-	rules := make([]RuleModel, 0, len(bench.Rules))
+	rules := make([]RuleDataSourceModel, 0, len(bench.Rules))
 	for _, r := range bench.Rules {
-		var references types.List
-		if len(r.References) == 0 {
-			references = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.References))
-			for j, ref := range r.References {
-				vals[j] = types.StringValue(ref)
-			}
-			references, _ = types.ListValue(types.StringType, vals)
-		}
-
-		var odvValue, odvHint, odvPlaceholder, odvType, odvValidationRegex types.String
-		var odvValidationMin, odvValidationMax types.Int64
-		var odvValidationEnumValues types.List
-		if r.ODV != nil {
-			odvValue = types.StringValue(r.ODV.Value)
-			odvHint = types.StringValue(r.ODV.Hint)
-			odvPlaceholder = types.StringValue(r.ODV.Placeholder)
-			odvType = types.StringValue(r.ODV.Type)
-			if r.ODV.Validation != nil {
-				if r.ODV.Validation.Min != nil {
-					odvValidationMin = types.Int64Value(int64(*r.ODV.Validation.Min))
-				} else {
-					odvValidationMin = types.Int64Null()
-				}
-				if r.ODV.Validation.Max != nil {
-					odvValidationMax = types.Int64Value(int64(*r.ODV.Validation.Max))
-				} else {
-					odvValidationMax = types.Int64Null()
-				}
-				enumValues := make([]attr.Value, len(r.ODV.Validation.EnumValues))
-				for k, v := range r.ODV.Validation.EnumValues {
-					enumValues[k] = types.StringValue(v)
-				}
-				if len(enumValues) == 0 {
-					odvValidationEnumValues = types.ListNull(types.StringType)
-				} else {
-					odvValidationEnumValues, _ = types.ListValue(types.StringType, enumValues)
-				}
-				odvValidationRegex = types.StringValue(r.ODV.Validation.Regex)
-			} else {
-				odvValidationMin = types.Int64Null()
-				odvValidationMax = types.Int64Null()
-				odvValidationEnumValues = types.ListNull(types.StringType)
-				odvValidationRegex = types.StringNull()
-			}
-		} else {
-			odvValue = types.StringNull()
-			odvHint = types.StringNull()
-			odvPlaceholder = types.StringNull()
-			odvType = types.StringNull()
-			odvValidationMin = types.Int64Null()
-			odvValidationMax = types.Int64Null()
-			odvValidationEnumValues = types.ListNull(types.StringType)
-			odvValidationRegex = types.StringNull()
-		}
-
-		var supportedOS types.List
-		osInfoObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"os_type":         types.StringType,
-				"os_version":      types.Int64Type,
-				"management_type": types.StringType,
-			},
-		}
-		if len(r.SupportedOS) == 0 {
-			supportedOS = types.ListNull(osInfoObjType)
-		} else {
-			osVals := make([]attr.Value, len(r.SupportedOS))
-			for j, os := range r.SupportedOS {
-				osVals[j], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"os_type":         types.StringType,
-						"os_version":      types.Int64Type,
-						"management_type": types.StringType,
-					},
-					map[string]attr.Value{
-						"os_type":         types.StringValue(os.OSType),
-						"os_version":      types.Int64Value(int64(os.OSVersion)),
-						"management_type": types.StringValue(os.ManagementType),
-					},
-				)
-			}
-			supportedOS, _ = types.ListValue(osInfoObjType, osVals)
-		}
-
-		osSpecObjType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"title":       types.StringType,
-				"description": types.StringType,
-				"odv_value":   types.StringType,
-				"odv_hint":    types.StringType,
-			},
-		}
-		var osSpecificDefaults types.Map
-		if len(r.OSSpecificDefaults) == 0 {
-			osSpecificDefaults = types.MapNull(osSpecObjType)
-		} else {
-			vals := make(map[string]attr.Value, len(r.OSSpecificDefaults))
-			for k, v := range r.OSSpecificDefaults {
-				var odvValue, odvHint types.String
-				if v.ODV != nil {
-					odvValue = types.StringValue(v.ODV.Value)
-					odvHint = types.StringValue(v.ODV.Hint)
-				} else {
-					odvValue = types.StringNull()
-					odvHint = types.StringNull()
-				}
-				vals[k], _ = types.ObjectValue(
-					map[string]attr.Type{
-						"title":       types.StringType,
-						"description": types.StringType,
-						"odv_value":   types.StringType,
-						"odv_hint":    types.StringType,
-					},
-					map[string]attr.Value{
-						"title":       types.StringValue(v.Title),
-						"description": types.StringValue(v.Description),
-						"odv_value":   odvValue,
-						"odv_hint":    odvHint,
-					},
-				)
-			}
-			osSpecificDefaults, _ = types.MapValue(osSpecObjType, vals)
-		}
-
-		var dependsOn types.List
-		if r.RuleRelation == nil || len(r.RuleRelation.DependsOn) == 0 {
-			dependsOn = types.ListNull(types.StringType)
-		} else {
-			vals := make([]attr.Value, len(r.RuleRelation.DependsOn))
-			for j, dep := range r.RuleRelation.DependsOn {
-				vals[j] = types.StringValue(dep)
-			}
-			dependsOn, _ = types.ListValue(types.StringType, vals)
-		}
-
-		rules = append(rules, RuleModel{
-			ID:                      types.StringValue(r.ID),
-			SectionName:             types.StringValue(r.SectionName),
-			Enabled:                 types.BoolValue(r.Enabled),
-			Title:                   types.StringValue(r.Title),
-			Description:             types.StringValue(r.Description),
-			References:              references,
-			ODVValue:                odvValue,
-			ODVHint:                 odvHint,
-			ODVPlaceholder:          odvPlaceholder,
-			ODVType:                 odvType,
-			ODVValidationMin:        odvValidationMin,
-			ODVValidationMax:        odvValidationMax,
-			ODVValidationEnumValues: odvValidationEnumValues,
-			ODVValidationRegex:      odvValidationRegex,
-			SupportedOS:             supportedOS,
-			OSSpecificDefaults:      osSpecificDefaults,
-			DependsOn:               dependsOn,
+		rules = append(rules, RuleDataSourceModel{
+			ID:        types.StringValue(r.ID),
+			RuleModel: ruleModelFromAPI(r),
 		})
 	}
 
+	targetDeviceGroups, diags := types.ListValueFrom(ctx, types.StringType, bench.Target.DeviceGroups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var targetDeviceGroup types.String
 	if len(bench.Target.DeviceGroups) > 0 {
 		targetDeviceGroup = types.StringValue(bench.Target.DeviceGroups[0])
@@ -442,18 +329,20 @@ func (d *BenchmarkDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	data = BenchmarkDataSourceModel{
-		ID:                data.ID,
-		BenchmarkID:       types.StringValue(bench.BenchmarkID),
-		TenantID:          types.StringValue(bench.TenantID),
-		Title:             types.StringValue(bench.Title),
-		Description:       types.StringValue(bench.Description),
-		Sources:           sources,
-		Rules:             rules,
-		TargetDeviceGroup: targetDeviceGroup,
-		EnforcementMode:   types.StringValue(bench.EnforcementMode),
-		Deleted:           types.BoolValue(bench.Deleted),
-		UpdateAvailable:   types.BoolValue(bench.UpdateAvailable),
-		LastUpdatedAt:     types.StringValue(bench.LastUpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+		ID:                 data.ID,
+		BenchmarkID:        types.StringValue(bench.BenchmarkID),
+		TenantID:           types.StringValue(bench.TenantID),
+		Title:              types.StringValue(bench.Title),
+		Description:        types.StringValue(bench.Description),
+		Sources:            sources,
+		Rules:              rules,
+		TargetDeviceGroups: targetDeviceGroups,
+		TargetDeviceGroup:  targetDeviceGroup,
+		EnforcementMode:    types.StringValue(bench.EnforcementMode),
+		Deleted:            types.BoolValue(bench.Deleted),
+		UpdateAvailable:    types.BoolValue(bench.UpdateAvailable),
+		SyncState:          syncState,
+		LastUpdatedAt:      types.StringValue(bench.LastUpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
 	}
 
 	tflog.Trace(ctx, "read a data source")
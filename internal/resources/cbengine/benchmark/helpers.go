@@ -4,25 +4,45 @@ package benchmark
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Defaults used when the timeouts block is not set.
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+)
+
+// importTitlePrefix marks an import ID as a benchmark title rather than a benchmark ID.
+const importTitlePrefix = "title:"
+
 // WaitForBenchmarkSync polls until the benchmark reaches a terminal state
 // (SYNCED or FAILED) or the provided context is canceled. The interval
-// controls how often the API is polled.
+// controls how often the API is polled. When the context deadline passes the
+// returned error includes the last observed syncState.
 func waitForBenchmarkSync(ctx context.Context, c *client.Client, id string, interval time.Duration) (*client.CBEngineBenchmarkV2, error) {
+	lastState := "NOT_PRESENT"
 	for {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, waitError(ctx, fmt.Sprintf("benchmark %s to sync", id), lastState)
 		case <-time.After(interval):
 			benchmarks, err := c.GetCBEngineBenchmarksV2(ctx)
 			if err != nil {
+				if ctx.Err() != nil {
+					return nil, waitError(ctx, fmt.Sprintf("benchmark %s to sync", id), lastState)
+				}
 				tflog.Debug(ctx, "polling benchmarks failed", map[string]interface{}{"error": err.Error()})
 				return nil, fmt.Errorf("failed to poll benchmarks: %w", err)
 			}
@@ -37,6 +57,7 @@ func waitForBenchmarkSync(ctx context.Context, c *client.Client, id string, inte
 				tflog.Debug(ctx, "benchmark not present yet", map[string]interface{}{"benchmark_id": id})
 				continue
 			}
+			lastState = found.SyncState
 			tflog.Debug(ctx, "benchmark syncState", map[string]interface{}{"benchmark_id": id, "sync_state": found.SyncState})
 			switch found.SyncState {
 			case "PENDING":
@@ -56,13 +77,17 @@ func waitForBenchmarkSync(ctx context.Context, c *client.Client, id string, inte
 // the context is canceled. Returns nil when the benchmark is absent. If the
 // API reports a DELETE_FAILED state an error is returned.
 func waitForBenchmarkDeletion(ctx context.Context, c *client.Client, id string, interval time.Duration) error {
+	lastState := "UNKNOWN"
 	for {
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return waitError(ctx, fmt.Sprintf("benchmark %s to be deleted", id), lastState)
 		case <-time.After(interval):
 			benchmarks, err := c.GetCBEngineBenchmarksV2(ctx)
 			if err != nil {
+				if ctx.Err() != nil {
+					return waitError(ctx, fmt.Sprintf("benchmark %s to be deleted", id), lastState)
+				}
 				tflog.Debug(ctx, "polling benchmarks failed", map[string]interface{}{"error": err.Error()})
 				return fmt.Errorf("failed to poll benchmarks: %w", err)
 			}
@@ -70,6 +95,7 @@ func waitForBenchmarkDeletion(ctx context.Context, c *client.Client, id string,
 			for _, b := range benchmarks.Benchmarks {
 				if b.ID == id {
 					present = true
+					lastState = b.SyncState
 					tflog.Debug(ctx, "benchmark still present during deletion poll", map[string]interface{}{
 						"benchmark_id": b.ID,
 						"sync_state":   b.SyncState,
@@ -91,13 +117,211 @@ func waitForBenchmarkDeletion(ctx context.Context, c *client.Client, id string,
 	}
 }
 
-// isNotFoundError checks if the error is a 404 not found error
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
+// waitError describes why a wait ended early, distinguishing a timeout from cancellation.
+func waitError(ctx context.Context, what, lastState string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for %s; last observed syncState: %s", what, lastState)
+	}
+	return fmt.Errorf("canceled while waiting for %s (last observed syncState: %s): %w", what, lastState, ctx.Err())
+}
+
+// benchmarkRequestFromModel builds the create/update request body from the Terraform model.
+func benchmarkRequestFromModel(ctx context.Context, data *BenchmarkResourceModel) (*client.CBEngineBenchmarkRequestV2, diag.Diagnostics) {
+	rules, diags := ruleRequestsFromModel(ctx, data)
+	deviceGroups, groupDiags := targetDeviceGroups(ctx, data)
+	diags.Append(groupDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	reqBody := &client.CBEngineBenchmarkRequestV2{
+		Title:            data.Title.ValueString(),
+		Description:      data.Description.ValueString(),
+		SourceBaselineID: data.SourceBaselineID.ValueString(),
+		Sources:          make([]client.CBEngineSourceV1, len(data.Sources)),
+		Rules:            rules,
+		Target: client.CBEngineTargetV2{
+			DeviceGroups: deviceGroups,
+		},
+		EnforcementMode: data.EnforcementMode.ValueString(),
+	}
+	for i, s := range data.Sources {
+		reqBody.Sources[i] = client.CBEngineSourceV1{
+			Branch:   s.Branch.ValueString(),
+			Revision: s.Revision.ValueString(),
+		}
+	}
+	return reqBody, diags
+}
+
+// targetDeviceGroups returns the sorted device groups targeted by the model,
+// falling back to the deprecated target_device_group while
+// target_device_groups is not known yet.
+func targetDeviceGroups(ctx context.Context, data *BenchmarkResourceModel) ([]string, diag.Diagnostics) {
+	if data.TargetDeviceGroups.IsNull() || data.TargetDeviceGroups.IsUnknown() {
+		if data.TargetDeviceGroup.IsNull() || data.TargetDeviceGroup.IsUnknown() {
+			return nil, nil
+		}
+		return []string{data.TargetDeviceGroup.ValueString()}, nil
+	}
+
+	var groups []string
+	diags := data.TargetDeviceGroups.ElementsAs(ctx, &groups, false)
+	sort.Strings(groups)
+	return groups, diags
+}
+
+// benchmarkSettingsChanged reports whether the request sent to the API differs
+// between the planned and prior benchmark configuration. The sources are only
+// compared when compareSources is set.
+func benchmarkSettingsChanged(ctx context.Context, plan, state *BenchmarkResourceModel, compareSources bool) (bool, diag.Diagnostics) {
+	planned, diags := benchmarkRequestFromModel(ctx, plan)
+	prior, priorDiags := benchmarkRequestFromModel(ctx, state)
+	diags.Append(priorDiags...)
+	if diags.HasError() {
+		return false, diags
+	}
+	if !compareSources {
+		planned.Sources, prior.Sources = nil, nil
+	}
+	return !reflect.DeepEqual(planned, prior), diags
+}
+
+// applyRuleMetadata fills the computed metadata of the planned rules that is
+// still unknown from the rules returned by the API, matched by rule ID. Rules
+// the API did not return keep empty metadata.
+func applyRuleMetadata(rules map[string]RuleModel, apiRules []client.CBEngineRuleInfoV1) {
+	apiRulesByID := make(map[string]client.CBEngineRuleInfoV1, len(apiRules))
+	for _, r := range apiRules {
+		apiRulesByID[r.ID] = r
+	}
+
+	for id, rule := range rules {
+		computed := ruleModelFromAPI(apiRulesByID[id])
+		if rule.Title.IsUnknown() {
+			computed.Enabled = rule.Enabled
+			if !rule.ODVValue.IsUnknown() {
+				computed.ODVValue = rule.ODVValue
+			}
+			rules[id] = computed
+		} else if rule.ODVValue.IsUnknown() {
+			rule.ODVValue = computed.ODVValue
+			rules[id] = rule
+		}
+	}
+}
+
+// ruleModelFromAPI converts a rule returned by the API into the Terraform rule model.
+func ruleModelFromAPI(r client.CBEngineRuleInfoV1) RuleModel {
+	var references types.List
+	if len(r.References) == 0 {
+		references = types.ListNull(types.StringType)
+	} else {
+		vals := make([]attr.Value, len(r.References))
+		for j, ref := range r.References {
+			vals[j] = types.StringValue(ref)
+		}
+		references, _ = types.ListValue(types.StringType, vals)
+	}
+
+	osInfoAttrTypes := map[string]attr.Type{
+		"os_type":         types.StringType,
+		"os_version":      types.Int64Type,
+		"management_type": types.StringType,
+	}
+	osInfoObjType := types.ObjectType{AttrTypes: osInfoAttrTypes}
+	var supportedOS types.List
+	if len(r.SupportedOS) == 0 {
+		supportedOS = types.ListNull(osInfoObjType)
+	} else {
+		osVals := make([]attr.Value, len(r.SupportedOS))
+		for j, os := range r.SupportedOS {
+			osVals[j], _ = types.ObjectValue(osInfoAttrTypes, map[string]attr.Value{
+				"os_type":         types.StringValue(os.OSType),
+				"os_version":      types.Int64Value(int64(os.OSVersion)),
+				"management_type": types.StringValue(os.ManagementType),
+			})
+		}
+		supportedOS, _ = types.ListValue(osInfoObjType, osVals)
+	}
+
+	osSpecAttrTypes := map[string]attr.Type{
+		"title":       types.StringType,
+		"description": types.StringType,
+		"odv_value":   types.StringType,
+		"odv_hint":    types.StringType,
+	}
+	osSpecObjType := types.ObjectType{AttrTypes: osSpecAttrTypes}
+	var osSpecificDefaults types.Map
+	if len(r.OSSpecificDefaults) == 0 {
+		osSpecificDefaults = types.MapNull(osSpecObjType)
+	} else {
+		vals := make(map[string]attr.Value, len(r.OSSpecificDefaults))
+		for k, v := range r.OSSpecificDefaults {
+			odvValue, odvHint := types.StringNull(), types.StringNull()
+			if v.ODV != nil {
+				odvValue = types.StringValue(v.ODV.Value)
+				odvHint = types.StringValue(v.ODV.Hint)
+			}
+			vals[k], _ = types.ObjectValue(osSpecAttrTypes, map[string]attr.Value{
+				"title":       types.StringValue(v.Title),
+				"description": types.StringValue(v.Description),
+				"odv_value":   odvValue,
+				"odv_hint":    odvHint,
+			})
+		}
+		osSpecificDefaults, _ = types.MapValue(osSpecObjType, vals)
 	}
-	errorStr := err.Error()
-	return strings.Contains(errorStr, "status 404") ||
-		strings.Contains(errorStr, "was not found") ||
-		strings.Contains(errorStr, "NOT_FOUND")
+
+	rule := RuleModel{
+		Enabled:                 types.BoolValue(r.Enabled),
+		SectionName:             types.StringValue(r.SectionName),
+		Title:                   types.StringValue(r.Title),
+		References:              references,
+		Description:             types.StringValue(r.Description),
+		SupportedOS:             supportedOS,
+		OSSpecificDefaults:      osSpecificDefaults,
+		ODVValue:                types.StringNull(),
+		ODVHint:                 types.StringNull(),
+		ODVPlaceholder:          types.StringNull(),
+		ODVType:                 types.StringNull(),
+		ODVValidationMin:        types.Int64Null(),
+		ODVValidationMax:        types.Int64Null(),
+		ODVValidationEnumValues: types.ListNull(types.StringType),
+		ODVValidationRegex:      types.StringNull(),
+		DependsOn:               types.ListNull(types.StringType),
+	}
+
+	if r.ODV != nil {
+		rule.ODVValue = types.StringValue(r.ODV.Value)
+		rule.ODVHint = types.StringValue(r.ODV.Hint)
+		rule.ODVPlaceholder = types.StringValue(r.ODV.Placeholder)
+		rule.ODVType = types.StringValue(r.ODV.Type)
+		if v := r.ODV.Validation; v != nil {
+			if v.Min != nil {
+				rule.ODVValidationMin = types.Int64Value(int64(*v.Min))
+			}
+			if v.Max != nil {
+				rule.ODVValidationMax = types.Int64Value(int64(*v.Max))
+			}
+			if len(v.EnumValues) > 0 {
+				enumValues := make([]attr.Value, len(v.EnumValues))
+				for k, ev := range v.EnumValues {
+					enumValues[k] = types.StringValue(ev)
+				}
+				rule.ODVValidationEnumValues, _ = types.ListValue(types.StringType, enumValues)
+			}
+			rule.ODVValidationRegex = types.StringValue(v.Regex)
+		}
+	}
+
+	if r.RuleRelation != nil && len(r.RuleRelation.DependsOn) > 0 {
+		vals := make([]attr.Value, len(r.RuleRelation.DependsOn))
+		for j, dep := range r.RuleRelation.DependsOn {
+			vals[j] = types.StringValue(dep)
+		}
+		rule.DependsOn, _ = types.ListValue(types.StringType, vals)
+	}
+
+	return rule
 }
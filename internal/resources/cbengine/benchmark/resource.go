@@ -6,9 +6,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,11 +22,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BenchmarkResource{}
 var _ resource.ResourceWithImportState = &BenchmarkResource{}
+var _ resource.ResourceWithModifyPlan = &BenchmarkResource{}
+var _ resource.ResourceWithConfigValidators = &BenchmarkResource{}
+var _ resource.ResourceWithUpgradeState = &BenchmarkResource{}
 
 // NewBenchmarkResource returns a new instance of BenchmarkResource.
 func NewBenchmarkResource() resource.Resource {
@@ -36,66 +45,65 @@ func (r *BenchmarkResource) Metadata(ctx context.Context, req resource.MetadataR
 // Schema returns the Terraform schema for the benchmark resource.
 func (r *BenchmarkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Creates a Jamf Compliance Benchmark. Creation is asynchronous: the API accepts the request and deploys associated artifacts to the MDM. The provider will poll the benchmark sync state until it reaches SYNCED or a terminal failure.",
+		Version:     2,
+		Description: "Creates a Jamf Compliance Benchmark. Creation and updates are asynchronous: the API accepts the request and deploys associated artifacts to the MDM. The provider will poll the benchmark sync state until it reaches SYNCED or a terminal failure. Title, description, rules, target and enforcement mode are updated in place; changing the source baseline or sources replaces the benchmark. Rules are validated against the source baseline during plan: unknown rule IDs, organization-defined values that break the rule constraints and enabled rules whose dependencies are disabled are rejected.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier assigned by the API (maps to benchmarkId).",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"title": schema.StringAttribute{
-				Description: "Benchmark title (max length 100). Required and replaces the resource when changed.",
+				Description: "Benchmark title (max length 100).",
 				Required:    true,
 				Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Optional human-readable description of the benchmark (max length 1000). Replaces the resource when changed.",
+				Description: "Optional human-readable description of the benchmark (max length 1000).",
 				Optional:    true,
 				Validators:  []validator.String{stringvalidator.LengthBetween(0, 1000)},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"source_baseline_id": schema.StringAttribute{
-				Description: "mSCP baseline identifier used as the source for rules. Required and immutable for this resource (replace on change).",
+				Description: "mSCP baseline identifier used as the source for rules. Required and immutable for this resource (replace on change). The API does not return it, so after import the configured value is adopted without replacing the benchmark.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						sourceBaselineRequiresReplace,
+						"Changing the source baseline replaces the benchmark, except when adopting the value after import.",
+						"Changing the source baseline replaces the benchmark, except when adopting the value after import.",
+					),
 				},
 			},
 			"sources": schema.ListNestedAttribute{
-				Description: "List of mSCP sources (branch + revision) to include in the benchmark. Required; changing sources requires replace.",
+				Description: "List of mSCP sources (branch + revision) to include in the benchmark. Required; changing sources requires replace unless auto_accept_baseline_updates is enabled. With auto_accept_baseline_updates enabled, the sources are only used to create the benchmark and afterwards follow the accepted baseline updates: the sources returned by the API are kept in state and changes to the configured sources are ignored.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"branch": schema.StringAttribute{
 							Description: "Source branch name.",
 							Required:    true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
 						},
 						"revision": schema.StringAttribute{
 							Description: "Source revision identifier.",
 							Required:    true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
 						},
 					},
 				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(
+						sourcesRequireReplace,
+						"Changing sources replaces the benchmark unless auto_accept_baseline_updates is enabled.",
+						"Changing sources replaces the benchmark unless `auto_accept_baseline_updates` is enabled.",
+					),
+				},
 			},
-			"rules": schema.ListNestedAttribute{
-				Description: "Ordered list of rules to include in the benchmark. Each entry references a rule id and whether it is enabled; additional metadata (title, section, ODV hints) are computed from the API.",
-				Required:    true,
+			"rules": schema.MapNestedAttribute{
+				Description: "Rules to include in the benchmark, keyed by rule ID from the baseline. Each entry sets whether the rule is enabled; additional metadata (title, section, ODV hints) are computed from the API and matched by rule ID. Exactly one of rules or rule_selection must be set.",
+				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "Rule identifier from the baseline.",
-							Required:    true,
-						},
 						"enabled": schema.BoolAttribute{
 							Description: "Whether the rule is enabled in this benchmark.",
 							Required:    true,
@@ -202,30 +210,89 @@ func (r *BenchmarkResource) Schema(ctx context.Context, req resource.SchemaReque
 						},
 					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+			},
+			"rule_selection": schema.SingleNestedAttribute{
+				Description: "Selects all rules of the source baseline with their baseline defaults, then applies the section filters, rule exclusions and ODV overrides. The resulting rules are shown in effective_rules during plan. Exactly one of rules or rule_selection must be set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"exclude_rules": schema.SetAttribute{
+						Description: "IDs of baseline rules to leave out of the benchmark.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"include_sections": schema.SetAttribute{
+						Description: "Only include rules from these baseline sections. Defaults to all sections.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"exclude_sections": schema.SetAttribute{
+						Description: "Leave out the rules from these baseline sections.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"odv_overrides": schema.MapAttribute{
+						Description: "Organization-defined values keyed by rule ID, replacing the baseline defaults of the selected rules.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"effective_rules": schema.MapNestedAttribute{
+				Description: "Rules sent to the API, keyed by rule ID. Resolved against the source baseline during plan from rules or rule_selection, with baseline defaults for unset organization-defined values.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the rule is enabled.",
+							Computed:    true,
+						},
+						"odv_value": schema.StringAttribute{
+							Description: "Organization-defined value of the rule, if it has one.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"resolve_dependencies": schema.BoolAttribute{
+				Description: "When true, the transitive dependencies of every enabled rule are enabled automatically, adding rules of the source baseline with their defaults where needed. The added rules show in effective_rules and dependency_rules during plan, and dependency cycles are rejected. Defaults to false.",
+				Optional:    true,
+			},
+			"dependency_rules": schema.SetAttribute{
+				Description: "IDs of the rules enabled by resolve_dependencies to satisfy the dependencies of enabled rules. Null unless resolve_dependencies is true.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"target_device_groups": schema.SetAttribute{
+				Description: "Set of device group Platform IDs targeted by this benchmark. Specified as a set of strings in UUID format. The Platform ID can be sourced from the response body of the /api/v1/groups Jamf Pro API endpoint. Order does not matter. Updated in place. Exactly one of target_device_groups or target_device_group must be set.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
+						"Each device group ID must be a valid UUID",
+					)),
 				},
 			},
 			"target_device_group": schema.StringAttribute{
-				Description: "Device group Platform ID targeted by this benchmark. Specified as a string in UUID format. The Platform ID can be sourced from the response body of the /api/v1/groups Jamf Pro API endpoint. Required and immutable for this resource (replace on change).",
-				Required:    true,
+				Description:        "Device group Platform ID targeted by this benchmark. Specified as a string in UUID format. Updated in place. Deprecated: use target_device_groups instead.",
+				DeprecationMessage: "Use target_device_groups instead. The target_device_group attribute will be removed in a future release.",
+				Optional:           true,
 				Validators: []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
-					"Device group IDmust be a valid UUID")},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+					"Device group ID must be a valid UUID")},
 			},
 			"enforcement_mode": schema.StringAttribute{
-				Description: "Enforcement mode for the benchmark; allowed values: MONITOR or MONITOR_AND_ENFORCE. Required and immutable for this resource (replace on change).",
+				Description: "Enforcement mode for the benchmark; allowed values: MONITOR or MONITOR_AND_ENFORCE. Updated in place.",
 				Required:    true,
 				Validators:  []validator.String{stringvalidator.OneOf("MONITOR", "MONITOR_AND_ENFORCE")},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"tenant_id": schema.StringAttribute{
 				Description: "Identifier for the tenant that owns the benchmark.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"deleted": schema.BoolAttribute{
 				Description: "Whether the benchmark is marked deleted by the API.",
@@ -235,11 +302,33 @@ func (r *BenchmarkResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "Whether an update is available for the benchmark relative to current mSCP sources.",
 				Computed:    true,
 			},
+			"auto_accept_baseline_updates": schema.BoolAttribute{
+				Description: "When true, a pending mSCP baseline update (update_available) is accepted during apply and the provider waits for the benchmark to return to SYNCED. The pending update shows in the plan as update_available changing to false. Defaults to false.",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often the provider polls the benchmark sync state while waiting for create, update or delete to finish, as a duration string (e.g. \"10s\"). Defaults to 5s.",
+				Optional:    true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`),
+					"must be a duration string such as 5s, 1m or 1m30s",
+				)},
+			},
 			"last_updated_at": schema.StringAttribute{
 				Description: "Timestamp (RFC3339) of the last update to the benchmark.",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "Maximum time to wait for the benchmark to reach SYNCED after creation. Defaults to 30m.",
+				UpdateDescription: "Maximum time to wait for the benchmark to reach SYNCED after an update. Defaults to 30m.",
+				DeleteDescription: "Maximum time to wait for the benchmark to be removed. Defaults to 30m.",
+			}),
+		},
 	}
 }
 
@@ -263,7 +352,213 @@ func (r *BenchmarkResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
-// ImportState handles the import of existing Benchmark resources.
+// ConfigValidators requires exactly one way of choosing the benchmark rules
+// and exactly one way of setting the target device groups.
+func (r *BenchmarkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("rules"),
+			path.MatchRoot("rule_selection"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("target_device_groups"),
+			path.MatchRoot("target_device_group"),
+		),
+	}
+}
+
+// ImportState handles the import of existing Benchmark resources by ID or,
+// with the title: prefix, by exact benchmark title.
 func (r *BenchmarkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := req.ID
+	if title, ok := strings.CutPrefix(req.ID, importTitlePrefix); ok {
+		benchmark, err := r.client.GetCBEngineBenchmarkByTitleV2(ctx, title)
+		if err != nil {
+			switch {
+			case client.IsAmbiguous(err):
+				resp.Diagnostics.AddError(
+					"Ambiguous benchmark title",
+					"Could not import benchmark by title: "+err.Error()+". Import the benchmark by ID instead.",
+				)
+			case client.IsNotFound(err):
+				resp.Diagnostics.AddError(
+					"Benchmark not found",
+					"Could not import benchmark by title: "+err.Error(),
+				)
+			default:
+				resp.Diagnostics.AddError(
+					"Error importing benchmark",
+					"Could not look up benchmark by title: "+err.Error(),
+				)
+			}
+			return
+		}
+		id = benchmark.BenchmarkID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ModifyPlan plans acceptance of a pending baseline update when
+// auto_accept_baseline_updates is enabled, so the update appears as a diff,
+// and keeps the sources managed by accepted updates. It also plans the target
+// device groups and resolves and validates the effective rules against the
+// source baseline, which planEffectiveRules reads from the API during plan.
+func (r *BenchmarkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var autoAccept, updateAvailable types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("auto_accept_baseline_updates"), &autoAccept)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("update_available"), &updateAvailable)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if autoAccept.ValueBool() && updateAvailable.ValueBool() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("update_available"), types.BoolValue(false))...)
+		}
+
+		// Accepted baseline updates move the sources on the server. Plan the
+		// sources from state so the configured sources neither show as a
+		// diff nor revert an accepted update.
+		if autoAccept.ValueBool() {
+			var sources types.List
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("sources"), &sources)...)
+			if !sources.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sources"), sources)...)
+			}
+		}
+	}
+
+	r.planTargetDeviceGroups(ctx, req, resp)
+	r.planEffectiveRules(ctx, req, resp)
+	r.planRuleMetadata(ctx, req, resp)
+}
+
+// planTargetDeviceGroups plans target_device_groups from the deprecated
+// target_device_group when it is configured, so that the targeted groups are
+// always shown, and compared, as a set.
+func (r *BenchmarkResource) planTargetDeviceGroups(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var group types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_device_group"), &group)...)
+	if resp.Diagnostics.HasError() || group.IsNull() {
+		return
+	}
+
+	groups := types.SetUnknown(types.StringType)
+	if !group.IsUnknown() {
+		groups = types.SetValueMust(types.StringType, []attr.Value{group})
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("target_device_groups"), groups)...)
+}
+
+// planEffectiveRules sets effective_rules and dependency_rules in the plan,
+// reading the rules of the source baseline from the API. The rules are read
+// from the configuration, where an unset odv_value is null rather than
+// unknown. Nothing is planned while the client or any rule input is unknown.
+func (r *BenchmarkResource) planEffectiveRules(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var baselineID types.String
+	var rules types.Map
+	var selection types.Object
+	var resolveDeps types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_baseline_id"), &baselineID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule_selection"), &selection)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resolve_dependencies"), &resolveDeps)...)
+	if resp.Diagnostics.HasError() || baselineID.IsNull() || !fullyKnown(ctx, baselineID, rules, selection, resolveDeps) {
+		return
+	}
+
+	data := BenchmarkResourceModel{SourceBaselineID: baselineID, ResolveDeps: resolveDeps}
+	resp.Diagnostics.Append(rules.ElementsAs(ctx, &data.Rules, false)...)
+	if !selection.IsNull() {
+		data.RuleSelection = &RuleSelectionModel{}
+		resp.Diagnostics.Append(selection.As(ctx, data.RuleSelection, basetypes.ObjectAsOptions{})...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.resolveEffectiveRules(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_rules"), data.EffectiveRules)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dependency_rules"), data.DependencyRules)...)
+}
+
+// planRuleMetadata carries the computed metadata of configured rules over from
+// the prior state, matched by rule ID, so that changes elsewhere do not show
+// every rule as changed. An unset odv_value is planned from effective_rules.
+func (r *BenchmarkResource) planRuleMetadata(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var rules, priorRules, effectiveRules types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("effective_rules"), &effectiveRules)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rules"), &priorRules)...)
+	}
+	if resp.Diagnostics.HasError() || rules.IsNull() || rules.IsUnknown() {
+		return
+	}
+
+	var planned, prior map[string]RuleModel
+	var effective map[string]EffectiveRuleModel
+	resp.Diagnostics.Append(rules.ElementsAs(ctx, &planned, false)...)
+	if !priorRules.IsNull() {
+		resp.Diagnostics.Append(priorRules.ElementsAs(ctx, &prior, false)...)
+	}
+	if !effectiveRules.IsNull() && !effectiveRules.IsUnknown() {
+		resp.Diagnostics.Append(effectiveRules.ElementsAs(ctx, &effective, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for id, rule := range planned {
+		if priorRule, ok := prior[id]; ok && rule.Title.IsUnknown() {
+			priorRule.Enabled = rule.Enabled
+			priorRule.ODVValue = rule.ODVValue
+			rule = priorRule
+		}
+		if effectiveRule, ok := effective[id]; ok && rule.ODVValue.IsUnknown() {
+			rule.ODVValue = effectiveRule.ODVValue
+		}
+		planned[id] = rule
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), planned)...)
+}
+
+// fullyKnown reports whether the values contain no unknown values at any depth.
+func fullyKnown(ctx context.Context, values ...attr.Value) bool {
+	for _, value := range values {
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !tfValue.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// sourcesRequireReplace forces replacement on a sources change unless baseline
+// updates are accepted in place.
+func sourcesRequireReplace(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	var autoAccept types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_accept_baseline_updates"), &autoAccept)...)
+	resp.RequiresReplace = !autoAccept.ValueBool()
+}
+
+// sourceBaselineRequiresReplace forces replacement on a source baseline change.
+// Imported benchmarks have no source baseline in state because the API does not
+// return it, so the configured value is adopted in place.
+func sourceBaselineRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
 }
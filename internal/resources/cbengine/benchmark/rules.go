@@ -0,0 +1,346 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// effectiveRuleAttrTypes are the attribute types of an effective_rules element.
+var effectiveRuleAttrTypes = map[string]attr.Type{
+	"enabled":   types.BoolType,
+	"odv_value": types.StringType,
+}
+
+// resolveEffectiveRules fetches the rules of the source baseline, resolves rule
+// dependencies when enabled, validates the rules or rule selection against the
+// baseline and sets the effective rules of the model.
+func (r *BenchmarkResource) resolveEffectiveRules(ctx context.Context, data *BenchmarkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	baseline, err := r.client.GetCBEngineRulesV1(ctx, data.SourceBaselineID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_baseline_id"),
+			"Unable to get baseline rules",
+			fmt.Sprintf("Could not get the rules of baseline %s: %s", data.SourceBaselineID.ValueString(), err),
+		)
+		return diags
+	}
+
+	rules, ruleDiags := expandRules(ctx, data, baseline.Rules)
+	diags.Append(ruleDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.DependencyRules = types.SetNull(types.StringType)
+	if data.ResolveDeps.ValueBool() {
+		var added []string
+		rules, added, ruleDiags = resolveDependencies(rules, baseline.Rules)
+		diags.Append(ruleDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.DependencyRules, ruleDiags = types.SetValueFrom(ctx, types.StringType, added)
+		diags.Append(ruleDiags...)
+	}
+
+	diags.Append(validateRules(ctx, data, baseline.Rules, rules)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.EffectiveRules, ruleDiags = effectiveRulesValue(rules)
+	diags.Append(ruleDiags...)
+	return diags
+}
+
+// expandRules builds the rule requests for the configured rules, or for the
+// rule selection, against the rules of the source baseline. Rules without an
+// organization-defined value get the baseline default.
+func expandRules(ctx context.Context, data *BenchmarkResourceModel, baseline []client.CBEngineRuleInfoV1) ([]client.CBEngineRuleRequestV2, diag.Diagnostics) {
+	if data.RuleSelection != nil {
+		return selectRules(ctx, data.RuleSelection, baseline)
+	}
+
+	defaults := make(map[string]string, len(baseline))
+	for _, rule := range baseline {
+		if rule.ODV != nil {
+			defaults[rule.ID] = rule.ODV.Value
+		}
+	}
+
+	rules := make([]client.CBEngineRuleRequestV2, 0, len(data.Rules))
+	for _, id := range sortedRuleIDs(data.Rules) {
+		rule := data.Rules[id]
+		odvValue := defaults[id]
+		if !rule.ODVValue.IsNull() && !rule.ODVValue.IsUnknown() && rule.ODVValue.ValueString() != "" {
+			odvValue = rule.ODVValue.ValueString()
+		}
+		rules = append(rules, ruleRequest(id, rule.Enabled.ValueBool(), odvValue))
+	}
+	return rules, nil
+}
+
+// selectRules includes every baseline rule with its baseline defaults, then
+// applies the section filters, rule exclusions and ODV overrides.
+func selectRules(ctx context.Context, selection *RuleSelectionModel, baseline []client.CBEngineRuleInfoV1) ([]client.CBEngineRuleRequestV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	selectionPath := path.Root("rule_selection")
+
+	var excludeRules, includeSections, excludeSections []string
+	odvOverrides := make(map[string]string)
+	diags.Append(selection.ExcludeRules.ElementsAs(ctx, &excludeRules, false)...)
+	diags.Append(selection.IncludeSections.ElementsAs(ctx, &includeSections, false)...)
+	diags.Append(selection.ExcludeSections.ElementsAs(ctx, &excludeSections, false)...)
+	diags.Append(selection.ODVOverrides.ElementsAs(ctx, &odvOverrides, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ruleIDs := make(map[string]bool, len(baseline))
+	sections := make(map[string]bool)
+	for _, rule := range baseline {
+		ruleIDs[rule.ID] = true
+		sections[rule.SectionName] = true
+	}
+
+	for _, id := range excludeRules {
+		if !ruleIDs[id] {
+			diags.AddAttributeError(
+				selectionPath.AtName("exclude_rules").AtSetValue(types.StringValue(id)),
+				"Unknown rule",
+				fmt.Sprintf("Rule %q is not part of the source baseline.", id),
+			)
+		}
+	}
+	for _, filter := range []struct {
+		name     string
+		sections []string
+	}{{"include_sections", includeSections}, {"exclude_sections", excludeSections}} {
+		for _, section := range filter.sections {
+			if !sections[section] {
+				diags.AddAttributeError(
+					selectionPath.AtName(filter.name).AtSetValue(types.StringValue(section)),
+					"Unknown section",
+					fmt.Sprintf("Section %q is not part of the source baseline.", section),
+				)
+			}
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	excludedRules := stringSet(excludeRules)
+	includedSections := stringSet(includeSections)
+	excludedSections := stringSet(excludeSections)
+
+	var rules []client.CBEngineRuleRequestV2
+	selected := make(map[string]bool)
+	for _, rule := range baseline {
+		if len(includedSections) > 0 && !includedSections[rule.SectionName] {
+			continue
+		}
+		if excludedSections[rule.SectionName] || excludedRules[rule.ID] {
+			continue
+		}
+
+		var odvValue string
+		if rule.ODV != nil {
+			odvValue = rule.ODV.Value
+		}
+		if override, ok := odvOverrides[rule.ID]; ok {
+			odvValue = override
+		}
+		rules = append(rules, ruleRequest(rule.ID, rule.Enabled, odvValue))
+		selected[rule.ID] = true
+	}
+
+	for _, id := range sortedRuleIDs(odvOverrides) {
+		if !selected[id] {
+			diags.AddAttributeError(
+				selectionPath.AtName("odv_overrides").AtMapKey(id),
+				"Unknown rule",
+				fmt.Sprintf("Rule %q is not part of the selected rules of the source baseline.", id),
+			)
+		}
+	}
+	return rules, diags
+}
+
+// resolveDependencies enables the transitive dependencies of every enabled
+// rule. Dependencies missing from the rules are added with their baseline
+// defaults. It returns the rules together with the sorted IDs of the rules it
+// enabled, and reports dependency cycles.
+func resolveDependencies(rules []client.CBEngineRuleRequestV2, baseline []client.CBEngineRuleInfoV1) ([]client.CBEngineRuleRequestV2, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baselineRules := make(map[string]client.CBEngineRuleInfoV1, len(baseline))
+	for _, rule := range baseline {
+		baselineRules[rule.ID] = rule
+	}
+	index := make(map[string]int, len(rules))
+	var enabled []string
+	for i, rule := range rules {
+		index[rule.ID] = i
+		if rule.Enabled {
+			enabled = append(enabled, rule.ID)
+		}
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	visits := make(map[string]int)
+	var added []string
+
+	var visit func(id string, trail []string)
+	visit = func(id string, trail []string) {
+		switch visits[id] {
+		case visiting:
+			cycle := append(slices.Clone(trail[slices.Index(trail, id):]), id)
+			diags.AddAttributeError(
+				path.Root("resolve_dependencies"),
+				"Rule dependency cycle",
+				fmt.Sprintf("The dependencies of rules %s form a cycle and cannot be resolved.", strings.Join(cycle, " -> ")),
+			)
+			return
+		case visited:
+			return
+		}
+
+		visits[id] = visiting
+		trail = append(trail, id)
+		if relation := baselineRules[id].RuleRelation; relation != nil {
+			for _, dependency := range relation.DependsOn {
+				info, ok := baselineRules[dependency]
+				if !ok {
+					continue
+				}
+				if i, ok := index[dependency]; !ok {
+					var odvValue string
+					if info.ODV != nil {
+						odvValue = info.ODV.Value
+					}
+					index[dependency] = len(rules)
+					rules = append(rules, ruleRequest(dependency, true, odvValue))
+					added = append(added, dependency)
+				} else if !rules[i].Enabled {
+					rules[i].Enabled = true
+					added = append(added, dependency)
+				}
+				visit(dependency, trail)
+			}
+		}
+		visits[id] = visited
+	}
+
+	for _, id := range enabled {
+		visit(id, nil)
+	}
+
+	sort.Strings(added)
+	return rules, added, diags
+}
+
+// ruleRequest builds a rule request, leaving out an empty organization-defined value.
+func ruleRequest(id string, enabled bool, odvValue string) client.CBEngineRuleRequestV2 {
+	rule := client.CBEngineRuleRequestV2{
+		ID:      id,
+		Enabled: enabled,
+	}
+	if odvValue != "" {
+		rule.ODV = &client.CBEngineODVRequestV2{Value: odvValue}
+	}
+	return rule
+}
+
+// ruleRequestsFromModel returns the rule requests of the model, ordered by
+// rule ID. State written before effective_rules existed falls back to rules.
+func ruleRequestsFromModel(ctx context.Context, data *BenchmarkResourceModel) ([]client.CBEngineRuleRequestV2, diag.Diagnostics) {
+	if data.EffectiveRules.IsNull() || data.EffectiveRules.IsUnknown() {
+		rules := make([]client.CBEngineRuleRequestV2, 0, len(data.Rules))
+		for _, id := range sortedRuleIDs(data.Rules) {
+			var odvValue string
+			if !data.Rules[id].ODVValue.IsUnknown() {
+				odvValue = data.Rules[id].ODVValue.ValueString()
+			}
+			rules = append(rules, ruleRequest(id, data.Rules[id].Enabled.ValueBool(), odvValue))
+		}
+		return rules, nil
+	}
+
+	var effective map[string]EffectiveRuleModel
+	diags := data.EffectiveRules.ElementsAs(ctx, &effective, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rules := make([]client.CBEngineRuleRequestV2, 0, len(effective))
+	for _, id := range sortedRuleIDs(effective) {
+		rules = append(rules, ruleRequest(id, effective[id].Enabled.ValueBool(), effective[id].ODVValue.ValueString()))
+	}
+	return rules, diags
+}
+
+// effectiveRulesValue converts rule requests into the effective_rules map.
+func effectiveRulesValue(rules []client.CBEngineRuleRequestV2) (types.Map, diag.Diagnostics) {
+	elems := make(map[string]attr.Value, len(rules))
+	for _, rule := range rules {
+		odvValue := types.StringNull()
+		if rule.ODV != nil {
+			odvValue = types.StringValue(rule.ODV.Value)
+		}
+		elems[rule.ID] = types.ObjectValueMust(effectiveRuleAttrTypes, map[string]attr.Value{
+			"enabled":   types.BoolValue(rule.Enabled),
+			"odv_value": odvValue,
+		})
+	}
+	return types.MapValue(types.ObjectType{AttrTypes: effectiveRuleAttrTypes}, elems)
+}
+
+// effectiveRulesFromAPI converts the rules of a benchmark returned by the API
+// into the effective_rules map.
+func effectiveRulesFromAPI(apiRules []client.CBEngineRuleInfoV1) (types.Map, diag.Diagnostics) {
+	rules := make([]client.CBEngineRuleRequestV2, len(apiRules))
+	for i, rule := range apiRules {
+		var odvValue string
+		if rule.ODV != nil {
+			odvValue = rule.ODV.Value
+		}
+		rules[i] = ruleRequest(rule.ID, rule.Enabled, odvValue)
+	}
+	return effectiveRulesValue(rules)
+}
+
+// sortedRuleIDs returns the keys of a rule map in lexical order.
+func sortedRuleIDs[T any](rules map[string]T) []string {
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// stringSet converts a list of strings into a lookup set.
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
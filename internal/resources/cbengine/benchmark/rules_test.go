@@ -0,0 +1,106 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+// baselineRule builds a baseline rule that depends on the given rules.
+func baselineRule(id string, dependsOn ...string) client.CBEngineRuleInfoV1 {
+	rule := client.CBEngineRuleInfoV1{ID: id}
+	if len(dependsOn) > 0 {
+		rule.RuleRelation = &client.CBEngineRuleRelationV1{DependsOn: dependsOn}
+	}
+	return rule
+}
+
+func TestResolveDependencies(t *testing.T) {
+	withODV := baselineRule("c")
+	withODV.ODV = &client.CBEngineOrganizationDefinedValueV1{Value: "15"}
+
+	tests := []struct {
+		name      string
+		rules     []client.CBEngineRuleRequestV2
+		baseline  []client.CBEngineRuleInfoV1
+		wantRules []client.CBEngineRuleRequestV2
+		wantAdded []string
+		wantError string
+	}{
+		{
+			name:      "dependency chain",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b"), baselineRule("b", "c"), withODV},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", true, ""), ruleRequest("c", true, "15")},
+			wantAdded: []string{"b", "c"},
+		},
+		{
+			name:      "dependency present but disabled",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", false, "3")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b"), baselineRule("b")},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", true, "3")},
+			wantAdded: []string{"b"},
+		},
+		{
+			name:      "dependency missing from rules",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b"), baselineRule("b")},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", true, "")},
+			wantAdded: []string{"b"},
+		},
+		{
+			name:      "dependency missing from baseline",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b")},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", true, "")},
+		},
+		{
+			name:      "dependencies of disabled rules",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", false, ""), ruleRequest("b", false, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b"), baselineRule("b")},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", false, ""), ruleRequest("b", false, "")},
+		},
+		{
+			name:      "shared dependency",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", true, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "c"), baselineRule("b", "c"), baselineRule("c")},
+			wantRules: []client.CBEngineRuleRequestV2{ruleRequest("a", true, ""), ruleRequest("b", true, ""), ruleRequest("c", true, "")},
+			wantAdded: []string{"c"},
+		},
+		{
+			name:      "cycle",
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("a", true, "")},
+			baseline:  []client.CBEngineRuleInfoV1{baselineRule("a", "b"), baselineRule("b", "c"), baselineRule("c", "b")},
+			wantError: "The dependencies of rules b -> c -> b form a cycle and cannot be resolved.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, added, diags := resolveDependencies(tt.rules, tt.baseline)
+
+			if tt.wantError != "" {
+				if diags.ErrorsCount() != 1 {
+					t.Fatalf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+				}
+				if got := diags.Errors()[0].Detail(); !strings.Contains(got, tt.wantError) {
+					t.Errorf("error = %q, want %q", got, tt.wantError)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(rules, tt.wantRules) {
+				t.Errorf("rules = %+v, want %+v", rules, tt.wantRules)
+			}
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("added = %v, want %v", added, tt.wantAdded)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package benchmark
 
 import (
 	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,18 +21,41 @@ type SourceModel struct {
 
 // BenchmarkResourceModel represents the Terraform resource model for a Jamf Compliance Benchmark.
 type BenchmarkResourceModel struct {
-	ID                types.String  `tfsdk:"id"`
-	Title             types.String  `tfsdk:"title"`
-	Description       types.String  `tfsdk:"description"`
-	SourceBaselineID  types.String  `tfsdk:"source_baseline_id"`
-	Sources           []SourceModel `tfsdk:"sources"`
-	Rules             []RuleModel   `tfsdk:"rules"`
-	TargetDeviceGroup types.String  `tfsdk:"target_device_group"`
-	EnforcementMode   types.String  `tfsdk:"enforcement_mode"`
-	TenantID          types.String  `tfsdk:"tenant_id"`
-	Deleted           types.Bool    `tfsdk:"deleted"`
-	UpdateAvailable   types.Bool    `tfsdk:"update_available"`
-	LastUpdatedAt     types.String  `tfsdk:"last_updated_at"`
+	ID                 types.String         `tfsdk:"id"`
+	Title              types.String         `tfsdk:"title"`
+	Description        types.String         `tfsdk:"description"`
+	SourceBaselineID   types.String         `tfsdk:"source_baseline_id"`
+	Sources            []SourceModel        `tfsdk:"sources"`
+	Rules              map[string]RuleModel `tfsdk:"rules"`
+	RuleSelection      *RuleSelectionModel  `tfsdk:"rule_selection"`
+	EffectiveRules     types.Map            `tfsdk:"effective_rules"`
+	ResolveDeps        types.Bool           `tfsdk:"resolve_dependencies"`
+	DependencyRules    types.Set            `tfsdk:"dependency_rules"`
+	TargetDeviceGroups types.Set            `tfsdk:"target_device_groups"`
+	TargetDeviceGroup  types.String         `tfsdk:"target_device_group"`
+	EnforcementMode    types.String         `tfsdk:"enforcement_mode"`
+	TenantID           types.String         `tfsdk:"tenant_id"`
+	Deleted            types.Bool           `tfsdk:"deleted"`
+	UpdateAvailable    types.Bool           `tfsdk:"update_available"`
+	AutoAcceptUpdates  types.Bool           `tfsdk:"auto_accept_baseline_updates"`
+	PollInterval       types.String         `tfsdk:"poll_interval"`
+	LastUpdatedAt      types.String         `tfsdk:"last_updated_at"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// RuleSelectionModel selects the rules of the source baseline with their
+// baseline defaults, narrowed by sections and exclusions.
+type RuleSelectionModel struct {
+	ExcludeRules    types.Set `tfsdk:"exclude_rules"`
+	IncludeSections types.Set `tfsdk:"include_sections"`
+	ExcludeSections types.Set `tfsdk:"exclude_sections"`
+	ODVOverrides    types.Map `tfsdk:"odv_overrides"`
+}
+
+// EffectiveRuleModel represents a rule of the rule set sent to the API.
+type EffectiveRuleModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	ODVValue types.String `tfsdk:"odv_value"`
 }
 
 // BenchmarkDataSource implements the Terraform data source for Jamf Compliance Benchmarks.
@@ -41,23 +65,30 @@ type BenchmarkDataSource struct {
 
 // BenchmarkDataSourceModel represents the Terraform data source model for a Jamf Compliance Benchmark.
 type BenchmarkDataSourceModel struct {
-	ID                types.String  `tfsdk:"id"`
-	Title             types.String  `tfsdk:"title"`
-	BenchmarkID       types.String  `tfsdk:"benchmark_id"`
-	TenantID          types.String  `tfsdk:"tenant_id"`
-	Description       types.String  `tfsdk:"description"`
-	Sources           []SourceModel `tfsdk:"sources"`
-	Rules             []RuleModel   `tfsdk:"rules"`
-	TargetDeviceGroup types.String  `tfsdk:"target_device_group"`
-	EnforcementMode   types.String  `tfsdk:"enforcement_mode"`
-	Deleted           types.Bool    `tfsdk:"deleted"`
-	UpdateAvailable   types.Bool    `tfsdk:"update_available"`
-	LastUpdatedAt     types.String  `tfsdk:"last_updated_at"`
+	ID                 types.String          `tfsdk:"id"`
+	Title              types.String          `tfsdk:"title"`
+	BenchmarkID        types.String          `tfsdk:"benchmark_id"`
+	TenantID           types.String          `tfsdk:"tenant_id"`
+	Description        types.String          `tfsdk:"description"`
+	Sources            []SourceModel         `tfsdk:"sources"`
+	Rules              []RuleDataSourceModel `tfsdk:"rules"`
+	TargetDeviceGroups types.List            `tfsdk:"target_device_groups"`
+	TargetDeviceGroup  types.String          `tfsdk:"target_device_group"`
+	EnforcementMode    types.String          `tfsdk:"enforcement_mode"`
+	Deleted            types.Bool            `tfsdk:"deleted"`
+	UpdateAvailable    types.Bool            `tfsdk:"update_available"`
+	SyncState          types.String          `tfsdk:"sync_state"`
+	LastUpdatedAt      types.String          `tfsdk:"last_updated_at"`
+}
+
+// RuleDataSourceModel represents a rule in the benchmark data source.
+type RuleDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	RuleModel
 }
 
-// RuleModel represents a rule in the benchmark, including ODV and computed fields.
+// RuleModel represents a rule in the benchmark, keyed by rule ID, including ODV and computed fields.
 type RuleModel struct {
-	ID                      types.String `tfsdk:"id"`
 	SectionName             types.String `tfsdk:"section_name"`
 	Enabled                 types.Bool   `tfsdk:"enabled"`
 	Title                   types.String `tfsdk:"title"`
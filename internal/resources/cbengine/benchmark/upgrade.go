@@ -0,0 +1,88 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// UpgradeState migrates state written by earlier versions of the resource schema.
+func (r *BenchmarkResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored rules as a list with an id attribute per rule.
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeRawState(req, resp, upgradeRulesToMap, upgradeTargetDeviceGroups)
+			},
+		},
+		// Version 1 stored a single target device group.
+		1: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeRawState(req, resp, upgradeTargetDeviceGroups)
+			},
+		},
+	}
+}
+
+// upgradeRawState applies the upgrade steps to the JSON state of an earlier schema version.
+func upgradeRawState(req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, steps ...func(map[string]interface{})) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade benchmark state",
+			"The prior state of the benchmark is not available as JSON.",
+		)
+		return
+	}
+
+	var state map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	dec.UseNumber()
+	if err := dec.Decode(&state); err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade benchmark state", err.Error())
+		return
+	}
+
+	for _, step := range steps {
+		step(state)
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade benchmark state", err.Error())
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// upgradeRulesToMap converts the version 0 rules list into a map keyed by rule ID.
+func upgradeRulesToMap(state map[string]interface{}) {
+	list, ok := state["rules"].([]interface{})
+	if !ok {
+		return
+	}
+
+	rules := make(map[string]interface{}, len(list))
+	for _, elem := range list {
+		rule, ok := elem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := rule["id"].(string)
+		delete(rule, "id")
+		rules[id] = rule
+	}
+	state["rules"] = rules
+}
+
+// upgradeTargetDeviceGroups sets target_device_groups from the single target
+// device group stored before version 2.
+func upgradeTargetDeviceGroups(state map[string]interface{}) {
+	if group, ok := state["target_device_group"].(string); ok {
+		state["target_device_groups"] = []interface{}{group}
+	}
+}
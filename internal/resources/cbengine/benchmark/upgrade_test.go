@@ -0,0 +1,131 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestBenchmarkResourceUpgradeState(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    int64
+		state      string
+		wantRules  map[string]interface{}
+		wantGroups interface{}
+	}{
+		{
+			name:    "version 0",
+			version: 0,
+			state: `{
+				"id": "benchmark-1",
+				"target_device_group": "group-1",
+				"rules": [
+					{"id": "os_firewall_enable", "enabled": true, "odv_value": null},
+					{"id": "pwpolicy_minimum_length_enforce", "enabled": false, "odv_value": "15"}
+				]
+			}`,
+			wantRules: map[string]interface{}{
+				"os_firewall_enable":              map[string]interface{}{"enabled": true, "odv_value": nil},
+				"pwpolicy_minimum_length_enforce": map[string]interface{}{"enabled": false, "odv_value": "15"},
+			},
+			wantGroups: []interface{}{"group-1"},
+		},
+		{
+			name:       "version 0 without rules",
+			version:    0,
+			state:      `{"id": "benchmark-1", "target_device_group": "group-1", "rules": null}`,
+			wantGroups: []interface{}{"group-1"},
+		},
+		{
+			name:    "version 1",
+			version: 1,
+			state: `{
+				"id": "benchmark-1",
+				"target_device_group": "group-1",
+				"rules": {"os_firewall_enable": {"enabled": true, "odv_value": null}}
+			}`,
+			wantRules: map[string]interface{}{
+				"os_firewall_enable": map[string]interface{}{"enabled": true, "odv_value": nil},
+			},
+			wantGroups: []interface{}{"group-1"},
+		},
+		{
+			name:    "version 1 without target device group",
+			version: 1,
+			state: `{
+				"id": "benchmark-1",
+				"target_device_group": null,
+				"rules": {"os_firewall_enable": {"enabled": true, "odv_value": null}}
+			}`,
+			wantRules: map[string]interface{}{
+				"os_firewall_enable": map[string]interface{}{"enabled": true, "odv_value": nil},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	r := &BenchmarkResource{}
+	upgraders := r.UpgradeState(ctx)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upgrader, ok := upgraders[tt.version]
+			if !ok {
+				t.Fatalf("no state upgrader for version %d", tt.version)
+			}
+
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.state)}}
+			var resp resource.UpgradeStateResponse
+			upgrader.StateUpgrader(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.DynamicValue == nil {
+				t.Fatal("upgrader returned no state")
+			}
+
+			var upgraded map[string]interface{}
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+				t.Fatalf("upgraded state is not valid JSON: %v", err)
+			}
+
+			if tt.wantRules != nil {
+				if got := upgraded["rules"]; !reflect.DeepEqual(got, tt.wantRules) {
+					t.Errorf("rules = %#v, want %#v", got, tt.wantRules)
+				}
+			} else if got := upgraded["rules"]; got != nil {
+				t.Errorf("rules = %#v, want null", got)
+			}
+
+			if groups := upgraded["target_device_groups"]; !reflect.DeepEqual(groups, tt.wantGroups) {
+				t.Errorf("target_device_groups = %#v, want %#v", groups, tt.wantGroups)
+			}
+
+			// The upgraded state must decode into the current schema.
+			if _, err := resp.DynamicValue.Unmarshal(schemaType); err != nil {
+				t.Errorf("upgraded state does not match the schema: %v", err)
+			}
+		})
+	}
+}
+
+func TestBenchmarkResourceUpgradeStateWithoutJSON(t *testing.T) {
+	upgraders := (&BenchmarkResource{}).UpgradeState(context.Background())
+
+	var resp resource.UpgradeStateResponse
+	upgraders[1].StateUpgrader(context.Background(), resource.UpgradeStateRequest{}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for state without JSON")
+	}
+}
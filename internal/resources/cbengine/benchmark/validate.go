@@ -0,0 +1,143 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateRules checks the expanded rules against the rules of the source
+// baseline. Every rule must exist in the baseline, configured
+// organization-defined values must satisfy the rule constraints, and the
+// dependencies of every enabled rule must be enabled.
+func validateRules(ctx context.Context, data *BenchmarkResourceModel, baseline []client.CBEngineRuleInfoV1, rules []client.CBEngineRuleRequestV2) diag.Diagnostics {
+	odvPaths, diags := configuredODVPaths(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	baselineRules := make(map[string]client.CBEngineRuleInfoV1, len(baseline))
+	for _, rule := range baseline {
+		baselineRules[rule.ID] = rule
+	}
+	enabled := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		enabled[rule.ID] = rule.Enabled
+	}
+
+	for _, rule := range rules {
+		info, ok := baselineRules[rule.ID]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("rules").AtMapKey(rule.ID),
+				"Unknown rule",
+				fmt.Sprintf("Rule %q is not part of the source baseline.", rule.ID),
+			)
+			continue
+		}
+
+		if odvPath, ok := odvPaths[rule.ID]; ok && rule.ODV != nil {
+			if problem := odvConstraintViolation(rule.ODV.Value, info.ODV); problem != "" {
+				diags.AddAttributeError(
+					odvPath,
+					"Invalid organization-defined value",
+					fmt.Sprintf("The organization-defined value of rule %q is invalid: %s.", rule.ID, problem),
+				)
+			}
+		}
+
+		if !rule.Enabled || info.RuleRelation == nil {
+			continue
+		}
+		for _, dependency := range info.RuleRelation.DependsOn {
+			if _, ok := baselineRules[dependency]; !ok || enabled[dependency] {
+				continue
+			}
+			diags.AddAttributeError(
+				ruleEnabledPath(data, rule.ID),
+				"Rule dependency not enabled",
+				fmt.Sprintf("Rule %q depends on rule %q, which is not enabled in the benchmark. Enable %q, disable %q or set resolve_dependencies to enable dependencies automatically.", rule.ID, dependency, dependency, rule.ID),
+			)
+		}
+	}
+	return diags
+}
+
+// configuredODVPaths returns the attribute path of every organization-defined
+// value set in the configuration, keyed by rule ID. Baseline defaults are not
+// included because they are not chosen by the practitioner.
+func configuredODVPaths(ctx context.Context, data *BenchmarkResourceModel) (map[string]path.Path, diag.Diagnostics) {
+	paths := make(map[string]path.Path)
+
+	if data.RuleSelection != nil {
+		overrides := make(map[string]string)
+		diags := data.RuleSelection.ODVOverrides.ElementsAs(ctx, &overrides, false)
+		for id := range overrides {
+			paths[id] = path.Root("rule_selection").AtName("odv_overrides").AtMapKey(id)
+		}
+		return paths, diags
+	}
+
+	for id, rule := range data.Rules {
+		if !rule.ODVValue.IsNull() && !rule.ODVValue.IsUnknown() && rule.ODVValue.ValueString() != "" {
+			paths[id] = path.Root("rules").AtMapKey(id).AtName("odv_value")
+		}
+	}
+	return paths, nil
+}
+
+// ruleEnabledPath returns the attribute path that enables a rule: the enabled
+// attribute of a configured rule, or the rule selection that included it.
+func ruleEnabledPath(data *BenchmarkResourceModel, id string) path.Path {
+	if data.RuleSelection != nil {
+		return path.Root("rule_selection")
+	}
+	return path.Root("rules").AtMapKey(id).AtName("enabled")
+}
+
+// odvConstraintViolation describes how a value breaks the validation
+// constraints of an organization-defined value, or returns an empty string if
+// it satisfies them. Patterns that cannot be compiled are not enforced.
+func odvConstraintViolation(value string, odv *client.CBEngineOrganizationDefinedValueV1) string {
+	if odv == nil || odv.Validation == nil {
+		return ""
+	}
+	constraints := odv.Validation
+
+	if odv.Type == "INTEGER" || constraints.Min != nil || constraints.Max != nil {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Sprintf("%q is not an integer", value)
+		}
+		if constraints.Min != nil && n < *constraints.Min {
+			return fmt.Sprintf("%d is less than the minimum of %d", n, *constraints.Min)
+		}
+		if constraints.Max != nil && n > *constraints.Max {
+			return fmt.Sprintf("%d is greater than the maximum of %d", n, *constraints.Max)
+		}
+	}
+
+	if len(constraints.EnumValues) > 0 && !slices.Contains(constraints.EnumValues, value) {
+		allowed := make([]string, len(constraints.EnumValues))
+		for i, v := range constraints.EnumValues {
+			allowed[i] = strconv.Quote(v)
+		}
+		return fmt.Sprintf("%q is not one of %s", value, strings.Join(allowed, ", "))
+	}
+
+	if constraints.Regex != "" {
+		if pattern, err := regexp.Compile(constraints.Regex); err == nil && !pattern.MatchString(value) {
+			return fmt.Sprintf("%q does not match the pattern %q", value, constraints.Regex)
+		}
+	}
+	return ""
+}
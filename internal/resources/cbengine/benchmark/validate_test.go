@@ -0,0 +1,225 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmark
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func intPointer(v int) *int {
+	return &v
+}
+
+func TestValidateRules(t *testing.T) {
+	lengthRule := baselineRule("pwpolicy_minimum_length_enforce")
+	lengthRule.ODV = &client.CBEngineOrganizationDefinedValueV1{
+		Value:      "15",
+		Type:       "INTEGER",
+		Validation: &client.CBEngineValidationConstraintsV1{Min: intPointer(8)},
+	}
+	baseline := []client.CBEngineRuleInfoV1{
+		baselineRule("os_firewall_enable"),
+		baselineRule("os_firewall_stealth_mode_enable", "os_firewall_enable"),
+		lengthRule,
+	}
+
+	configuredRule := func(enabled bool, odvValue string) RuleModel {
+		odv := types.StringNull()
+		if odvValue != "" {
+			odv = types.StringValue(odvValue)
+		}
+		return RuleModel{Enabled: types.BoolValue(enabled), ODVValue: odv}
+	}
+
+	tests := []struct {
+		name      string
+		data      BenchmarkResourceModel
+		rules     []client.CBEngineRuleRequestV2
+		wantPaths []path.Path
+	}{
+		{
+			name: "valid",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"os_firewall_enable":              configuredRule(true, ""),
+				"os_firewall_stealth_mode_enable": configuredRule(true, ""),
+				"pwpolicy_minimum_length_enforce": configuredRule(true, "12"),
+			}},
+			rules: []client.CBEngineRuleRequestV2{
+				ruleRequest("os_firewall_enable", true, ""),
+				ruleRequest("os_firewall_stealth_mode_enable", true, ""),
+				ruleRequest("pwpolicy_minimum_length_enforce", true, "12"),
+			},
+		},
+		{
+			name:      "unknown rule",
+			data:      BenchmarkResourceModel{Rules: map[string]RuleModel{"os_unknown": configuredRule(true, "")}},
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("os_unknown", true, "")},
+			wantPaths: []path.Path{path.Root("rules").AtMapKey("os_unknown")},
+		},
+		{
+			name: "dependency disabled",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"os_firewall_enable":              configuredRule(false, ""),
+				"os_firewall_stealth_mode_enable": configuredRule(true, ""),
+			}},
+			rules: []client.CBEngineRuleRequestV2{
+				ruleRequest("os_firewall_enable", false, ""),
+				ruleRequest("os_firewall_stealth_mode_enable", true, ""),
+			},
+			wantPaths: []path.Path{path.Root("rules").AtMapKey("os_firewall_stealth_mode_enable").AtName("enabled")},
+		},
+		{
+			name: "dependency missing",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"os_firewall_stealth_mode_enable": configuredRule(true, ""),
+			}},
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("os_firewall_stealth_mode_enable", true, "")},
+			wantPaths: []path.Path{path.Root("rules").AtMapKey("os_firewall_stealth_mode_enable").AtName("enabled")},
+		},
+		{
+			name: "dependent rule disabled",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"os_firewall_stealth_mode_enable": configuredRule(false, ""),
+			}},
+			rules: []client.CBEngineRuleRequestV2{ruleRequest("os_firewall_stealth_mode_enable", false, "")},
+		},
+		{
+			name: "invalid configured value",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"pwpolicy_minimum_length_enforce": configuredRule(true, "4"),
+			}},
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("pwpolicy_minimum_length_enforce", true, "4")},
+			wantPaths: []path.Path{path.Root("rules").AtMapKey("pwpolicy_minimum_length_enforce").AtName("odv_value")},
+		},
+		{
+			name: "invalid override in rule selection",
+			data: BenchmarkResourceModel{RuleSelection: &RuleSelectionModel{
+				ODVOverrides: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"pwpolicy_minimum_length_enforce": types.StringValue("4"),
+				}),
+			}},
+			rules:     []client.CBEngineRuleRequestV2{ruleRequest("pwpolicy_minimum_length_enforce", true, "4")},
+			wantPaths: []path.Path{path.Root("rule_selection").AtName("odv_overrides").AtMapKey("pwpolicy_minimum_length_enforce")},
+		},
+		{
+			name: "baseline default not validated",
+			data: BenchmarkResourceModel{Rules: map[string]RuleModel{
+				"pwpolicy_minimum_length_enforce": configuredRule(true, ""),
+			}},
+			rules: []client.CBEngineRuleRequestV2{ruleRequest("pwpolicy_minimum_length_enforce", true, "4")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateRules(context.Background(), &tt.data, baseline, tt.rules)
+
+			if got := diags.ErrorsCount(); got != len(tt.wantPaths) {
+				t.Fatalf("got %d errors, want %d: %v", got, len(tt.wantPaths), diags)
+			}
+			for i, d := range diags.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok {
+					t.Fatalf("error %q has no attribute path", d.Summary())
+				}
+				if !withPath.Path().Equal(tt.wantPaths[i]) {
+					t.Errorf("error %d path = %s, want %s", i, withPath.Path(), tt.wantPaths[i])
+				}
+			}
+		})
+	}
+}
+
+func TestODVConstraintViolation(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		odv   *client.CBEngineOrganizationDefinedValueV1
+		want  string
+	}{
+		{
+			name:  "no value definition",
+			value: "anything",
+		},
+		{
+			name:  "no constraints",
+			value: "anything",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Type: "STRING"},
+		},
+		{
+			name:  "within min",
+			value: "8",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Min: intPointer(8)}},
+		},
+		{
+			name:  "below min",
+			value: "7",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Min: intPointer(8)}},
+			want:  "7 is less than the minimum of 8",
+		},
+		{
+			name:  "within max",
+			value: "60",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Max: intPointer(60)}},
+		},
+		{
+			name:  "above max",
+			value: "61",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Max: intPointer(60)}},
+			want:  "61 is greater than the maximum of 60",
+		},
+		{
+			name:  "bounds on a non-integer",
+			value: "ten",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Min: intPointer(1)}},
+			want:  `"ten" is not an integer`,
+		},
+		{
+			name:  "integer type without bounds",
+			value: "1.5",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Type: "INTEGER", Validation: &client.CBEngineValidationConstraintsV1{}},
+			want:  `"1.5" is not an integer`,
+		},
+		{
+			name:  "enum member",
+			value: "high",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{EnumValues: []string{"low", "high"}}},
+		},
+		{
+			name:  "not an enum member",
+			value: "medium",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{EnumValues: []string{"low", "high"}}},
+			want:  `"medium" is not one of "low", "high"`,
+		},
+		{
+			name:  "regex match",
+			value: "abc123",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Regex: `^[a-z]+[0-9]+$`}},
+		},
+		{
+			name:  "regex mismatch",
+			value: "123abc",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Regex: `^[a-z]+[0-9]+$`}},
+			want:  `"123abc" does not match the pattern "^[a-z]+[0-9]+$"`,
+		},
+		{
+			name:  "invalid regex not enforced",
+			value: "anything",
+			odv:   &client.CBEngineOrganizationDefinedValueV1{Validation: &client.CBEngineValidationConstraintsV1{Regex: `(`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := odvConstraintViolation(tt.value, tt.odv); got != tt.want {
+				t.Errorf("odvConstraintViolation(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmarks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &BenchmarksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &BenchmarksDataSource{}
+)
+
+// NewBenchmarksDataSource returns a new instance of BenchmarksDataSource.
+func NewBenchmarksDataSource() datasource.DataSource {
+	return &BenchmarksDataSource{}
+}
+
+// Metadata sets the data source type name for the Terraform provider.
+func (d *BenchmarksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cbengine_benchmarks"
+}
+
+// Schema sets the Terraform schema for the data source.
+func (d *BenchmarksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns all benchmarks with their sync state, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				Description: "Only return benchmarks whose title matches this regular expression.",
+				Optional:    true,
+			},
+			"sync_state": schema.StringAttribute{
+				Description: "Only return benchmarks in this sync state (case-insensitive, e.g., 'FAILED').",
+				Optional:    true,
+			},
+			"update_available": schema.BoolAttribute{
+				Description: "Only return benchmarks whose update available flag has this value.",
+				Optional:    true,
+			},
+			"target_device_group": schema.StringAttribute{
+				Description: "Only return benchmarks that target this device group.",
+				Optional:    true,
+			},
+			"benchmarks": schema.ListNestedAttribute{
+				Description: "List of matching benchmarks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Benchmark ID.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Benchmark title.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description.",
+							Computed:    true,
+						},
+						"sync_state": schema.StringAttribute{
+							Description: "Sync state (e.g. PENDING, SYNCED, FAILED).",
+							Computed:    true,
+						},
+						"update_available": schema.BoolAttribute{
+							Description: "Update available flag.",
+							Computed:    true,
+						},
+						"target_device_groups": schema.ListAttribute{
+							Description: "Device groups targeted by the benchmark.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that title_regex is a valid regular expression.
+func (d *BenchmarksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var titleRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("title_regex"), &titleRegex)...)
+	if resp.Diagnostics.HasError() || titleRegex.IsNull() || titleRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(titleRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title_regex"),
+			"Invalid regular expression",
+			err.Error(),
+		)
+	}
+}
+
+// Configure sets up the API client for the data source from the provider configuration.
+func (d *BenchmarksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read fetches all benchmarks, applies the filters and populates the Terraform state.
+func (d *BenchmarksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BenchmarksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider client was not configured. Please ensure provider block is set up correctly.",
+		)
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !data.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(data.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	benchmarks, err := d.client.GetCBEngineBenchmarksV2(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get benchmarks",
+			err.Error(),
+		)
+		return
+	}
+
+	benchmarksList := []BenchmarkListModel{}
+	for _, b := range benchmarks.Benchmarks {
+		if titleRegex != nil && !titleRegex.MatchString(b.Title) {
+			continue
+		}
+		if !data.SyncState.IsNull() && !strings.EqualFold(b.SyncState, data.SyncState.ValueString()) {
+			continue
+		}
+		if !data.UpdateAvailable.IsNull() && b.UpdateAvailable != data.UpdateAvailable.ValueBool() {
+			continue
+		}
+		if !data.TargetDeviceGroup.IsNull() && !slices.ContainsFunc(b.Target.DeviceGroups, func(group string) bool {
+			return strings.EqualFold(group, data.TargetDeviceGroup.ValueString())
+		}) {
+			continue
+		}
+
+		deviceGroups, diags := types.ListValueFrom(ctx, types.StringType, b.Target.DeviceGroups)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		benchmarksList = append(benchmarksList, BenchmarkListModel{
+			ID:                 types.StringValue(b.ID),
+			Title:              types.StringValue(b.Title),
+			Description:        types.StringValue(b.Description),
+			SyncState:          types.StringValue(b.SyncState),
+			UpdateAvailable:    types.BoolValue(b.UpdateAvailable),
+			TargetDeviceGroups: deviceGroups,
+		})
+	}
+
+	data.Benchmarks = benchmarksList
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
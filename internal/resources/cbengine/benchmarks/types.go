@@ -0,0 +1,32 @@
+// Copyright 2025 Jamf Software LLC.
+
+package benchmarks
+
+import (
+	"github.com/Jamf-Concepts/terraform-provider-jamfplatform/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BenchmarksDataSource defines the data source for listing benchmarks.
+type BenchmarksDataSource struct {
+	client *client.Client
+}
+
+// BenchmarksDataSourceModel defines the data structure for the benchmarks data source.
+type BenchmarksDataSourceModel struct {
+	TitleRegex        types.String         `tfsdk:"title_regex"`
+	SyncState         types.String         `tfsdk:"sync_state"`
+	UpdateAvailable   types.Bool           `tfsdk:"update_available"`
+	TargetDeviceGroup types.String         `tfsdk:"target_device_group"`
+	Benchmarks        []BenchmarkListModel `tfsdk:"benchmarks"`
+}
+
+// BenchmarkListModel defines the data structure for a benchmark in the list.
+type BenchmarkListModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Title              types.String `tfsdk:"title"`
+	Description        types.String `tfsdk:"description"`
+	SyncState          types.String `tfsdk:"sync_state"`
+	UpdateAvailable    types.Bool   `tfsdk:"update_available"`
+	TargetDeviceGroups types.List   `tfsdk:"target_device_groups"`
+}
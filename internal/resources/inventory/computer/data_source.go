@@ -272,6 +272,13 @@ func (d *DataSourceComputer) Read(ctx context.Context, req datasource.ReadReques
 
 	computer, err := d.client.GetInventoryComputerByIDV1(ctx, id)
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Computer not found",
+				fmt.Sprintf("No computer with ID %s exists in inventory.", id),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to get computer",
 			fmt.Sprintf("Error retrieving computer with ID %s: %s", id, err),
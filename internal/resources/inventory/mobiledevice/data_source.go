@@ -452,6 +452,13 @@ func (d *DataSourceMobileDevice) Read(ctx context.Context, req datasource.ReadRe
 
 	mobileDevice, err := d.client.GetInventoryMobileDeviceByIDV1(ctx, id, sections)
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Mobile device not found",
+				fmt.Sprintf("No mobile device with ID %s exists in inventory.", id),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to get mobile device",
 			fmt.Sprintf("Error retrieving mobile device with ID %s: %s", id, err),
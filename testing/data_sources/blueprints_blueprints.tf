@@ -0,0 +1,3 @@
+data "jamfplatform_blueprints_blueprints" "test_all_blueprints" {
+  sort = ["name:asc"]
+}
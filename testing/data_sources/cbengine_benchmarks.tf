@@ -0,0 +1,2 @@
+data "jamfplatform_cbengine_benchmarks" "test_all_benchmarks" {
+}
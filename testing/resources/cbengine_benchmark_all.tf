@@ -20,13 +20,12 @@ resource "jamfplatform_cbengine_benchmark" "test_all_benchmarks" {
     }
   ]
 
-  rules = [
-    for r in data.jamfplatform_cbengine_rules.test_all_rules[each.key].rules : {
-      id      = r.id
+  rules = {
+    for r in data.jamfplatform_cbengine_rules.test_all_rules[each.key].rules : r.id => {
       enabled = r.enabled
     }
-  ]
+  }
 
-  target_device_group = data.jamfpro_group.test_target_computer_group.group_platform_id
-  enforcement_mode    = "MONITOR"
+  target_device_groups = [data.jamfpro_group.test_target_computer_group.group_platform_id]
+  enforcement_mode     = "MONITOR"
 }
@@ -0,0 +1,21 @@
+resource "jamfplatform_cbengine_benchmark" "test_rule_selection" {
+  title              = "Terraform Test Rule Selection ${var.test_id}"
+  description        = "Managed by Terraform - baseline defaults with overrides"
+  source_baseline_id = "cis_lvl1"
+
+  sources = [
+    for s in data.jamfplatform_cbengine_rules.test_all_rules["cis_lvl1"].sources : {
+      branch   = s.branch
+      revision = s.revision
+    }
+  ]
+
+  rule_selection = {
+    exclude_rules = [data.jamfplatform_cbengine_rules.test_all_rules["cis_lvl1"].rules[0].id]
+  }
+
+  resolve_dependencies = true
+
+  target_device_groups = [data.jamfpro_group.test_target_computer_group.group_platform_id]
+  enforcement_mode     = "MONITOR"
+}